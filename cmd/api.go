@@ -0,0 +1,123 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/r3per/hanged-game/internal/api"
+	"github.com/r3per/hanged-game/internal/game"
+	"github.com/r3per/hanged-game/internal/storage"
+)
+
+// Domyślny adres serwera REST API
+const DefaultAPIAddr = ":8080"
+
+// Domyślny plik statystyk serwera REST API
+// (osobny, bo gra w konsoli przechowuje statystyki w pamięci i nadpisałaby wyniki zapisane przez serwer)
+const APIStatsFilePath = "data/api_stats.json"
+
+// runAPICommand obsługuje podkomendę "api": silnik gry udostępniony jako REST API (JSON)
+func runAPICommand(args []string) int {
+	flags := flag.NewFlagSet("api", flag.ContinueOnError)
+	addr := flags.String("addr", DefaultAPIAddr, "adres, pod którym serwer nasłuchuje")
+	language := flags.String("lang", "pl", "język paczki słów")
+	difficultyName := flags.String("difficulty", game.DefaultDifficulty, "domyślny poziom trudności nowych gier")
+	scoringName := flags.String("scoring", game.DefaultScoringPolicy,
+		"zasady punktacji: "+strings.Join(game.ScoringPolicyNames(), ", "))
+	seed := flags.Int64("seed", 0, "ziarno losowania słów (0 = losowe)")
+	ttl := flags.Duration("ttl", api.DefaultGameTTL, "czas, po którym nieużywana gra jest usuwana")
+	statsPath := flags.String("stats", APIStatsFilePath, "plik statystyk zakończonych gier")
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+
+	newGame, err := newAPIGameFactory(*language, *difficultyName, *scoringName, *seed)
+	if err != nil {
+		fmt.Printf("Nie można uruchomić serwera: %v\n", err)
+		return 1
+	}
+
+	if err := os.MkdirAll(filepath.Dir(*statsPath), 0755); err != nil {
+		fmt.Printf("Nie można utworzyć katalogu danych: %v\n", err)
+		return 1
+	}
+	statsManager, err := storage.NewStatsManager(*statsPath)
+	if err != nil {
+		fmt.Printf("Błąd podczas ładowania statystyk: %v\n", err)
+		return 1
+	}
+
+	apiServer := api.NewServer(newGame, statsManager, *ttl)
+	httpServer := &http.Server{
+		Addr:              *addr,
+		Handler:           apiServer,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	// Zamknij serwer po przerwaniu (Ctrl+C)
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt)
+	go func() {
+		<-interrupt
+		// Najpierw zakończ strumienie obserwatorów, inaczej serwer czekałby na ich rozłączenie
+		apiServer.Close()
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		httpServer.Shutdown(ctx)
+	}()
+
+	fmt.Printf("REST API nasłuchuje na %s (Ctrl+C kończy pracę)\n", *addr)
+	if err := httpServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		fmt.Printf("Błąd serwera: %v\n", err)
+		return 1
+	}
+	return 0
+}
+
+// newAPIGameFactory przygotowuje funkcję tworzącą gry na wybranym poziomie trudności
+func newAPIGameFactory(language, difficultyName, scoringName string, seed int64) (api.GameFactory, error) {
+	scoring, ok := game.GetScoringPolicy(scoringName)
+	if !ok {
+		return nil, fmt.Errorf("nieznane zasady punktacji: %s (dostępne: %s)", scoringName, strings.Join(game.ScoringPolicyNames(), ", "))
+	}
+
+	difficultyManager, err := game.NewDifficultyManager(DifficultyFilePath)
+	if err != nil {
+		return nil, fmt.Errorf("błąd podczas ładowania poziomów trudności: %v", err)
+	}
+	if _, ok := difficultyManager.Get(difficultyName); !ok {
+		return nil, fmt.Errorf("nieznany poziom trudności: %s", difficultyName)
+	}
+
+	wordsManager, err := game.NewWordsManagerFromDir(WordsDirPath, language)
+	if err != nil {
+		return nil, fmt.Errorf("błąd podczas ładowania słów: %v", err)
+	}
+	if seed != 0 {
+		wordsManager.SetRandSource(rand.NewSource(seed))
+	}
+
+	return func(difficultyID string) (*game.Game, error) {
+		if difficultyID == "" {
+			difficultyID = difficultyName
+		}
+		difficulty, ok := difficultyManager.Get(difficultyID)
+		if !ok {
+			return nil, fmt.Errorf("nieznany poziom trudności: %s", difficultyID)
+		}
+
+		g := game.NewGameFromWord(wordsManager.GetRandomWordForDifficulty(difficulty), difficulty, game.GameModifiers{}, scoring)
+		g.SetSeed(wordsManager.NewGameSeed())
+		g.Alphabet.Strict = strictLetters
+		return g, nil
+	}, nil
+}
@@ -0,0 +1,100 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"strings"
+
+	"github.com/r3per/hanged-game/internal/game"
+)
+
+// runBenchCommand obsługuje podkomendę "bench": automatyczny gracz rozgrywa serie gier
+// bez interfejsu, a wyniki są zestawiane dla każdego poziomu trudności i strategii
+func runBenchCommand(args []string) int {
+	flags := flag.NewFlagSet("bench", flag.ContinueOnError)
+	games := flags.Int("games", 1000, "liczba gier dla każdego poziomu trudności i strategii")
+	strategies := flags.String("strategy", strings.Join(game.SolverStrategyNames(), ","),
+		"strategie oddzielone przecinkami: "+strings.Join(game.SolverStrategyNames(), ", "))
+	difficulties := flags.String("difficulty", "", "identyfikatory poziomów trudności oddzielone przecinkami (domyślnie wszystkie)")
+	scoringName := flags.String("scoring", game.DefaultScoringPolicy,
+		"zasady punktacji: "+strings.Join(game.ScoringPolicyNames(), ", "))
+	seed := flags.Int64("seed", 1, "ziarno losowania słów i ruchów")
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if flags.NArg() > 1 || *games < 1 {
+		fmt.Println("Użycie: hangman bench [opcje] [paczka]")
+		return 2
+	}
+
+	scoring, ok := game.GetScoringPolicy(*scoringName)
+	if !ok {
+		fmt.Printf("Nieznane zasady punktacji: %s (dostępne: %s)\n", *scoringName, strings.Join(game.ScoringPolicyNames(), ", "))
+		return 2
+	}
+
+	// Domyślnie paczka polska
+	pack := "pl"
+	if flags.NArg() == 1 {
+		pack = flags.Arg(0)
+	}
+	path := resolveWordPack(pack)
+	words, err := game.LoadWordsFile(path)
+	if err != nil {
+		fmt.Printf("Błąd podczas wczytywania paczki: %v\n", err)
+		return 1
+	}
+
+	// Słowa bez własnego języka należą do języka paczki
+	language := game.PackLanguage(path)
+	for i := range words {
+		if words[i].Language == "" {
+			words[i].Language = language
+		}
+	}
+	wordsManager := game.NewWordsManagerFromWords(words, language)
+
+	difficultyManager, err := game.NewDifficultyManager(DifficultyFilePath)
+	if err != nil {
+		fmt.Printf("Błąd podczas ładowania poziomów trudności: %v\n", err)
+		return 1
+	}
+
+	levels := difficultyManager.GetAll()
+	if *difficulties != "" {
+		levels = nil
+		for _, id := range strings.Split(*difficulties, ",") {
+			difficulty, ok := difficultyManager.Get(strings.TrimSpace(id))
+			if !ok {
+				fmt.Printf("Nieznany poziom trudności: %s\n", id)
+				return 2
+			}
+			levels = append(levels, difficulty)
+		}
+	}
+
+	fmt.Printf("Paczka: %s (słów: %d), gier: %d, punktacja: %s, ziarno: %d\n\n",
+		path, len(words), *games, scoring.Name(), *seed)
+	fmt.Printf("%-10s %-12s %10s %14s %12s\n", "Poziom", "Strategia", "Wygrane", "Śr. błędów", "Śr. wynik")
+
+	for _, difficulty := range levels {
+		for _, name := range strings.Split(*strategies, ",") {
+			result, ok := game.RunBenchmark(wordsManager, game.BenchConfig{
+				Games:      *games,
+				Difficulty: difficulty,
+				Strategy:   strings.TrimSpace(name),
+				Scoring:    scoring,
+				Seed:       *seed,
+			})
+			if !ok {
+				fmt.Printf("Nieznana strategia: %s (dostępne: %s)\n", name, strings.Join(game.SolverStrategyNames(), ", "))
+				return 2
+			}
+
+			fmt.Printf("%-10s %-12s %9.1f%% %14.2f %12.1f\n",
+				result.Difficulty, result.Strategy, result.WinRate(), result.AverageWrongGuesses(), result.AverageScore())
+		}
+	}
+
+	return 0
+}
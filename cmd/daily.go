@@ -0,0 +1,94 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/r3per/hanged-game/internal/game"
+	"github.com/r3per/hanged-game/internal/localization"
+	"github.com/r3per/hanged-game/internal/storage"
+	"github.com/r3per/hanged-game/internal/ui"
+)
+
+// playDailyChallenge prowadzi wyzwanie dnia (raz dziennie dla każdego profilu)
+// Wszyscy gracze dostają tego dnia to samo słowo, bez przedmiotów i bonusów z atrybutów RPG
+func playDailyChallenge(consoleUI *ui.ConsoleUI, wordsManager *game.WordsManager, dailyManager *storage.DailyManager, difficultyManager *game.DifficultyManager, txt localization.Translations) {
+	now := time.Now()
+	date := game.DailyDate(now)
+
+	consoleUI.ClearScreen()
+
+	// Wyzwanie można rozegrać tylko raz dziennie
+	if result, ok := dailyManager.GetResult(profile, date); ok {
+		if result.Result == storage.DailyStarted {
+			fmt.Println(consoleUI.CenterText(ui.Yellow + "Dzisiejsze wyzwanie zostało przerwane i nie można go powtórzyć. Wróć jutro!" + ui.Reset))
+		} else {
+			fmt.Println(consoleUI.CenterText(ui.Yellow + "Dzisiejsze wyzwanie zostało już rozegrane. Wróć jutro!" + ui.Reset))
+			fmt.Println()
+			printDailySummary(consoleUI, result.Summary)
+		}
+		consoleUI.WaitForEnter()
+		return
+	}
+
+	difficulty := difficultyManager.GetOrDefault(game.DefaultDifficulty)
+	word := wordsManager.GetDailyWord(now, difficulty)
+
+	g := game.NewGameFromWord(word, difficulty, game.GameModifiers{}, game.ClassicScoring{})
+	g.Alphabet.Strict = strictLetters
+
+	// Zapisz rozpoczęcie wyzwania przed grą, aby wyjście z gry nie pozwalało zagrać ponownie
+	err := dailyManager.RecordResult(profile, storage.DailyResult{
+		Date:         date,
+		Word:         g.Word,
+		Result:       storage.DailyStarted,
+		DifficultyID: g.Difficulty.ID,
+		Language:     g.Language,
+	})
+	if err != nil {
+		fmt.Println(consoleUI.CenterText(ui.Red + fmt.Sprintf("Błąd podczas zapisywania wyniku wyzwania: %v", err) + ui.Reset))
+		consoleUI.WaitForEnter()
+		return
+	}
+
+	runGameLoop(consoleUI, g, nil)
+
+	// Wyświetl wynik gry
+	consoleUI.ClearScreen()
+	consoleUI.PrintGameState(g)
+
+	result := "lose"
+	if g.State == game.Won {
+		result = "win"
+		fmt.Println(consoleUI.CenterText(ui.BgGreen + ui.Bold + txt.Messages.Congratulations + " " + txt.Messages.YouWon + " " + g.Word + ui.Reset))
+	} else {
+		fmt.Println(consoleUI.CenterText(ui.BgRed + ui.Bold + txt.Messages.YouLost + " " + g.Word + ui.Reset))
+	}
+
+	summary := game.DailySummary(g, date)
+	fmt.Println()
+	printDailySummary(consoleUI, summary)
+
+	err = dailyManager.RecordResult(profile, storage.DailyResult{
+		Date:         date,
+		Word:         g.Word,
+		Result:       result,
+		Points:       g.Points,
+		DifficultyID: g.Difficulty.ID,
+		Language:     g.Language,
+		Summary:      summary,
+	})
+	if err != nil {
+		fmt.Println(consoleUI.CenterText(ui.Red + fmt.Sprintf("Błąd podczas zapisywania wyniku wyzwania: %v", err) + ui.Reset))
+	}
+
+	consoleUI.WaitForEnter()
+}
+
+// printDailySummary wyświetla podsumowanie wyzwania dnia w formie gotowej do skopiowania
+func printDailySummary(consoleUI *ui.ConsoleUI, summary string) {
+	fmt.Println(consoleUI.CenterText(ui.Bold + "Skopiuj i udostępnij swój wynik:" + ui.Reset))
+	fmt.Println()
+	fmt.Println(summary)
+	fmt.Println()
+}
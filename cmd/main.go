@@ -1,9 +1,12 @@
 package main
 
 import (
+	"flag"
 	"fmt"
+	"math/rand"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/r3per/hanged-game/internal/game"
 	"github.com/r3per/hanged-game/internal/localization"
@@ -12,16 +15,64 @@ import (
 )
 
 const (
-	WordsFilePath      = "data/words.txt"
+	WordsDirPath       = "data/words"
 	StatsFilePath      = "data/stats.json"
+	CharacterFilePath  = "data/character.json"
+	DifficultyFilePath = "data/difficulties.json"
+	WordBagFilePath    = "data/wordbag.json"
+	DailyFilePath      = "data/daily.json"
 	LanguageConfigPath = "data/language.txt"
 )
 
 var (
-	difficultyLevel = 2 // Domyślnie średni poziom trudności
+	difficultyID  = game.DefaultDifficulty // Domyślnie średni poziom trudności
+	strictLetters = false                  // Czy litery ze znakami diakrytycznymi trzeba odgadywać osobno
+	profile       = storage.DefaultProfile // Profil gracza (wyzwanie dnia można rozegrać raz dziennie na profil)
+	advisorHints  = false                  // Czy Inteligencja podsuwa litery od doradcy zamiast je odkrywać
 )
 
 func main() {
+	// Podkomendy: paczki słów, porównywanie poziomów trudności, gra sieciowa i REST API
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "words":
+			os.Exit(runWordsCommand(os.Args[2:]))
+		case "bench":
+			os.Exit(runBenchCommand(os.Args[2:]))
+		case "serve":
+			os.Exit(runServeCommand(os.Args[2:]))
+		case "join":
+			os.Exit(runJoinCommand(os.Args[2:]))
+		case "api":
+			os.Exit(runAPICommand(os.Args[2:]))
+		}
+	}
+
+	scoringName := flag.String("scoring", game.DefaultScoringPolicy,
+		"zasady punktacji: "+strings.Join(game.ScoringPolicyNames(), ", "))
+	recentWindow := flag.Int("recent-window", 10, "liczba ostatnich gier, których słowa nie są ponownie losowane")
+	seed := flag.Int64("seed", 0, "ziarno losowania: ta sama wartość daje tę samą sekwencję słów i przedmiotów")
+	flag.StringVar(&profile, "profile", storage.DefaultProfile, "nazwa profilu gracza (dla wyzwania dnia)")
+	flag.BoolVar(&advisorHints, "advisor", false, "Inteligencja podsuwa najczęstszą literę wśród pasujących słów zamiast odkrywać literę")
+	advisorDebug := flag.Bool("advisor-debug", false, "pokazuj na ekranie gry liczbę pasujących słów i najczęstsze litery")
+	flag.BoolVar(&strictLetters, "strict-letters", false, "litery ze znakami diakrytycznymi (np. ą, ß) trzeba odgadywać osobno")
+	replay := flag.Int("replay", 0, "odtwórz n-tą od końca grę ze statystyk (1 = ostatnia) z tym samym słowem, ustawieniami i ziarnem")
+	flag.Parse()
+
+	// Sprawdź czy podano ziarno (również 0 jest poprawnym ziarnem)
+	seeded := false
+	flag.Visit(func(f *flag.Flag) {
+		if f.Name == "seed" {
+			seeded = true
+		}
+	})
+
+	scoring, ok := game.GetScoringPolicy(*scoringName)
+	if !ok {
+		fmt.Printf("Nieznane zasady punktacji: %s (dostępne: %s)\n", *scoringName, strings.Join(game.ScoringPolicyNames(), ", "))
+		os.Exit(1)
+	}
+
 	// Upewnij się, że katalog data istnieje
 	dataDir := filepath.Dir(StatsFilePath)
 	if _, err := os.Stat(dataDir); os.IsNotExist(err) {
@@ -45,12 +96,33 @@ func main() {
 	txt := langManager.GetText()
 
 	// Inicjalizacja menedżera słów
-	wordsManager, err := game.NewWordsManager(WordsFilePath)
+	wordsManager, err := game.NewWordsManagerFromDir(WordsDirPath, string(langManager.CurrentLanguage))
 	if err != nil {
 		fmt.Printf("Błąd podczas ładowania słów: %v\n", err)
 		os.Exit(1)
 	}
 
+	// Wczytaj worek słów, aby słowa nie powtarzały się między sesjami
+	wordBagManager, err := storage.NewWordBagManager(WordBagFilePath)
+	if err != nil {
+		fmt.Printf("Błąd podczas ładowania worka słów: %v\n", err)
+		os.Exit(1)
+	}
+	if seeded {
+		// Sesja z ziarnem zaczyna od pełnego worka i nie zmienia zapisanego worka,
+		// aby ta sama wartość ziarna zawsze dawała tę samą sekwencję słów
+		wordsManager.SetRandSource(rand.NewSource(*seed))
+	} else {
+		wordsManager.SetShuffleBag(wordBagManager.GetBag())
+	}
+
+	// Inicjalizacja menedżera poziomów trudności
+	difficultyManager, err := game.NewDifficultyManager(DifficultyFilePath)
+	if err != nil {
+		fmt.Printf("Błąd podczas ładowania poziomów trudności: %v\n", err)
+		os.Exit(1)
+	}
+
 	// Inicjalizacja menedżera statystyk
 	statsManager, err := storage.NewStatsManager(StatsFilePath)
 	if err != nil {
@@ -58,13 +130,32 @@ func main() {
 		os.Exit(1)
 	}
 
+	// Inicjalizacja menedżera wyzwań dnia
+	dailyManager, err := storage.NewDailyManager(DailyFilePath)
+	if err != nil {
+		fmt.Printf("Błąd podczas ładowania wyzwań dnia: %v\n", err)
+		os.Exit(1)
+	}
+
+	// Inicjalizacja menedżera postaci RPG
+	characterManager, err := storage.NewCharacterManager(CharacterFilePath)
+	if err != nil {
+		fmt.Printf("Błąd podczas ładowania postaci: %v\n", err)
+		os.Exit(1)
+	}
+
 	// Inicjalizacja interfejsu użytkownika
 	consoleUI := ui.NewConsoleUI()
+	consoleUI.SetDebugOverlay(*advisorDebug)
+
+	// Odtworzenie zapisanej gry zamiast menu głównego
+	if *replay > 0 {
+		os.Exit(playReplay(consoleUI, wordsManager, difficultyManager, statsManager, txt, *replay))
+	}
 
 	// Inicjalizacja interfejsu RPG
-	rpgLevel := game.NewRPGLevel()
-	quests := game.GenerateBasicQuests()
-	rpgUI := ui.NewRPGCharacterUI(rpgLevel, quests)
+	rpgLevel := characterManager.GetLevel()
+	rpgUI := ui.NewRPGCharacterUI(rpgLevel, characterManager.GetQuests())
 
 	// Główna pętla programu
 	for {
@@ -81,11 +172,18 @@ func main() {
 
 		switch option {
 		case 1: // Nowa gra
-			playGame(consoleUI, wordsManager, statsManager, langManager, rpgLevel)
+			difficulty := difficultyManager.GetOrDefault(difficultyID)
+			if !seeded {
+				wordsManager.SetRecentWords(statsManager.GetRecentWords(*recentWindow))
+			}
+			playGame(consoleUI, wordsManager, statsManager, langManager, characterManager, difficulty, scoring, "")
+			rpgUI.SetQuests(characterManager.GetQuests())
+			saveCharacter(consoleUI, characterManager)
+			saveWordBag(consoleUI, wordBagManager)
 		case 2: // Wybierz poziom trudności
-			selectDifficulty(consoleUI, txt)
+			selectDifficulty(consoleUI, difficultyManager, txt)
 		case 3: // Pokaż statystyki
-			showStats(consoleUI, statsManager, txt)
+			showStats(consoleUI, statsManager, difficultyManager, txt)
 		case 4: // Pokaż ekwipunek
 			rpgUI.PrintInventory(consoleUI)
 			consoleUI.WaitForEnter()
@@ -94,11 +192,37 @@ func main() {
 			consoleUI.WaitForEnter()
 		case 6: // Sklep z przedmiotami
 			showItemShop(consoleUI, rpgLevel, rpgUI)
+			saveCharacter(consoleUI, characterManager)
 		case 7: // Wybierz język
-			selectLanguage(consoleUI, langManager)
+			selectLanguage(consoleUI, langManager, wordsManager)
 			// Zapisz preferencje językowe
 			saveLanguagePreference(LanguageConfigPath, string(langManager.CurrentLanguage))
-		case 8: // Wyjście
+		case 8: // Wyzwanie dnia
+			playDailyChallenge(consoleUI, wordsManager, dailyManager, difficultyManager, txt)
+		case 9: // Inne tryby gry
+			mode, ok := selectGameMode(consoleUI)
+			if !ok {
+				continue
+			}
+			difficulty := difficultyManager.GetOrDefault(difficultyID)
+			if mode == game.ModeTwoPlayer {
+				playTwoPlayerGame(consoleUI, wordsManager, statsManager, difficulty, scoring, txt)
+				continue
+			}
+			if !seeded {
+				wordsManager.SetRecentWords(statsManager.GetRecentWords(*recentWindow))
+			}
+			if mode == game.ModeRace {
+				playRace(consoleUI, wordsManager, statsManager, difficulty, scoring)
+				saveWordBag(consoleUI, wordBagManager)
+				continue
+			}
+			playGame(consoleUI, wordsManager, statsManager, langManager, characterManager, difficulty, scoring, mode)
+			rpgUI.SetQuests(characterManager.GetQuests())
+			saveCharacter(consoleUI, characterManager)
+			saveWordBag(consoleUI, wordBagManager)
+		case 10: // Wyjście
+			saveCharacter(consoleUI, characterManager)
 			fmt.Println(consoleUI.CenterText(txt.Messages.PressEnterToContinue))
 			return
 		default:
@@ -108,24 +232,49 @@ func main() {
 	}
 }
 
-// playGame prowadzi rozgrywkę
-func playGame(consoleUI *ui.ConsoleUI, wordsManager *game.WordsManager, statsManager *storage.StatsManager, langManager *localization.LanguageManager, rpgLevel *game.RPGLevel) {
-	txt := langManager.GetText()
+// gameModes to tryby gry dostępne w menu "Inne tryby gry"
+var gameModes = []struct {
+	ID   string
+	Name string
+}{
+	{game.ModeEvil, "Złośliwy wisielec (komputer zmienia słowo, aby uniknąć Twoich liter)"},
+	{game.ModeTwoPlayer, "Gra dwuosobowa (jeden gracz wpisuje słowo, drugi je odgaduje)"},
+	{game.ModeRace, "Wyścig (kilku graczy na zmianę odgaduje to samo słowo, wygrywa najszybszy)"},
+}
 
-	// Utwórz nową grę
-	g := consoleUI.SetupGame(wordsManager)
+// selectGameMode pozwala wybrać jeden z dodatkowych trybów gry
+func selectGameMode(consoleUI *ui.ConsoleUI) (string, bool) {
+	consoleUI.ClearScreen()
+	names := make([]string, len(gameModes))
+	for i, mode := range gameModes {
+		names[i] = mode.Name
+	}
+	consoleUI.PrintGameModeMenu(names)
 
-	// Główna pętla gry
-	for g.State == game.Playing {
-		consoleUI.ClearScreen()
-		consoleUI.PrintGameState(g)
+	option := consoleUI.GetMenuOption()
+	if option < 1 || option > len(gameModes) {
+		return "", false
+	}
+	return gameModes[option-1].ID, true
+}
 
-		// Pobierz literę od użytkownika
-		letter := consoleUI.GetLetterInput()
+// playGame prowadzi rozgrywkę w podanym trybie (pusty tryb oznacza zwykłą grę)
+func playGame(consoleUI *ui.ConsoleUI, wordsManager *game.WordsManager, statsManager *storage.StatsManager, langManager *localization.LanguageManager, characterManager *storage.CharacterManager, difficulty game.Difficulty, scoring game.ScoringPolicy, mode string) {
+	txt := langManager.GetText()
+	rpgLevel := characterManager.GetLevel()
 
-		// Dokonaj próby odgadnięcia
-		g.Guess(letter)
+	// Utwórz nową grę
+	var g *game.Game
+	switch mode {
+	case game.ModeEvil:
+		g = consoleUI.SetupEvilGame(wordsManager, difficulty, rpgLevel.GetGameModifiers(), scoring)
+	default:
+		g = consoleUI.SetupGame(wordsManager, difficulty, rpgLevel.GetGameModifiers(), scoring)
 	}
+	g.Alphabet.Strict = strictLetters
+	g.AdvisorHints = advisorHints
+
+	runGameLoop(consoleUI, g, rpgLevel)
 
 	// Wyświetl wynik gry
 	consoleUI.ClearScreen()
@@ -139,22 +288,24 @@ func playGame(consoleUI *ui.ConsoleUI, wordsManager *game.WordsManager, statsMan
 		pointsMsg := ui.Bold + ui.Green + txt.Messages.YouEarned + " " + fmt.Sprintf("%d", g.Points) + " " + txt.Messages.Points + "!" + ui.Reset
 		fmt.Println(consoleUI.CenterText(pointsMsg))
 		// Zapisz wynik jako wygraną
-		statsManager.AddGameResult(g.Word, "win", g.Points, g.MaxAttempts)
+		recordGame(statsManager, g, "win")
 
 		// Dodaj doświadczenie
 		leveledUp, levelsGained := rpgLevel.AddExperience(g.Points)
 
-		// Aktualizuj zadania (questy)
-		updatedQuests, questXP := game.UpdateQuests(game.GenerateBasicQuests(), "win_games", 1)
+		// Aktualizuj zadania (questy) wszystkimi zdarzeniami z tej gry
+		updatedQuests, completedQuests, questXP := game.ApplyQuestEvents(characterManager.GetQuests(), game.GetQuestEvents(g))
+		characterManager.SetQuests(updatedQuests)
 		if questXP > 0 {
-			rpgLevel.AddExperience(questXP)
+			questLeveledUp, questLevels := rpgLevel.AddExperience(questXP)
+			leveledUp = leveledUp || questLeveledUp
+			levelsGained += questLevels
 		}
 
+		rpgUI := ui.NewRPGCharacterUI(rpgLevel, updatedQuests)
+
 		// Jeśli gracz awansował na wyższy poziom, wyświetl informację
 		if leveledUp {
-			// Utwórz nowy interfejs RPG z zaktualizowanymi danymi
-			rpgUI := ui.NewRPGCharacterUI(rpgLevel, updatedQuests)
-
 			// Wyświetl informację o awansie na wyższy poziom
 			consoleUI.ClearScreen()
 			rpgUI.PrintLevelUpNotification(consoleUI, rpgLevel.Level)
@@ -168,15 +319,11 @@ func playGame(consoleUI *ui.ConsoleUI, wordsManager *game.WordsManager, statsMan
 			consoleUI.WaitForEnter()
 		}
 
-		// Sprawdź, czy ukończono jakieś zadania i wyświetl informację
-		for _, quest := range updatedQuests {
-			if quest.Completed && quest.Progress == quest.Target {
-				rpgUI := ui.NewRPGCharacterUI(rpgLevel, updatedQuests)
-				consoleUI.ClearScreen()
-				rpgUI.PrintQuestCompleteNotification(consoleUI, quest)
-				consoleUI.WaitForEnter()
-				break
-			}
+		// Wyświetl informację o każdym zadaniu ukończonym w tej grze
+		for _, quest := range completedQuests {
+			consoleUI.ClearScreen()
+			rpgUI.PrintQuestCompleteNotification(consoleUI, quest)
+			consoleUI.WaitForEnter()
 		}
 	} else {
 		// Użyj przetłumaczonych tekstów do komunikatu o przegranej
@@ -186,21 +333,160 @@ func playGame(consoleUI *ui.ConsoleUI, wordsManager *game.WordsManager, statsMan
 		pointsMsg := ui.Bold + ui.Red + txt.Messages.YouEarned + " " + fmt.Sprintf("%d", g.Points) + " " + txt.Messages.Points + "." + ui.Reset
 		fmt.Println(consoleUI.CenterText(pointsMsg))
 		// Zapisz wynik jako przegraną
-		statsManager.AddGameResult(g.Word, "lose", g.Points, g.MaxAttempts)
+		recordGame(statsManager, g, "lose")
 	}
 
 	consoleUI.WaitForEnter()
 }
 
+// runGameLoop prowadzi rozgrywkę aż do jej zakończenia
+// Bez poziomu postaci (nil) przedmioty z ekwipunku są niedostępne
+func runGameLoop(consoleUI *ui.ConsoleUI, g *game.Game, rpgLevel *game.RPGLevel) {
+	// Główna pętla gry
+	notice := ""
+	for g.State == game.Playing {
+		consoleUI.ClearScreen()
+		consoleUI.PrintGameState(g)
+		if notice != "" {
+			fmt.Println(consoleUI.CenterText(notice))
+			notice = ""
+		}
+
+		// Pobierz literę od użytkownika
+		input := consoleUI.GetLetterInput(g.Alphabet)
+
+		if input.UseItem {
+			if rpgLevel == nil {
+				notice = ui.Red + "Przedmioty są niedostępne w tym trybie" + ui.Reset
+			} else {
+				notice = useItemInGame(consoleUI, g, rpgLevel)
+			}
+			continue
+		}
+
+		if input.UseHint {
+			if g.RevealHint(game.HintCost) {
+				notice = ui.Yellow + fmt.Sprintf("Odkryto podpowiedź (-%d pkt)", game.HintCost) + ui.Reset
+			} else {
+				notice = ui.Red + "Podpowiedź jest niedostępna" + ui.Reset
+			}
+			continue
+		}
+
+		// Dokonaj próby odgadnięcia całego słowa lub litery
+		if input.Word != "" {
+			g.GuessWord(input.Word)
+		} else {
+			g.Guess(input.Letter)
+		}
+	}
+}
+
+// recordGame zapisuje wynik gry w statystykach
+func recordGame(statsManager *storage.StatsManager, g *game.Game, result string) {
+	modifiers := g.Modifiers
+	gameStats := storage.GameStats{
+		Word:          g.Word,
+		Result:        result,
+		Points:        g.Points,
+		DifficultyID:  g.Difficulty.ID,
+		Scoring:       g.Scoring.Name(),
+		Language:      g.Language,
+		Seed:          g.Seed,
+		Mode:          g.Mode,
+		Modifiers:     &modifiers,
+		StrictLetters: g.Alphabet.Strict,
+		AdvisorHints:  g.AdvisorHints,
+	}
+	// Słowo startowe jest potrzebne tylko wtedy, gdy słowo zmieniło się w trakcie gry
+	if g.StartWord != g.Word {
+		gameStats.StartWord = g.StartWord
+	}
+
+	err := statsManager.RecordGame(gameStats)
+	if err != nil {
+		fmt.Printf("Błąd podczas zapisywania statystyk: %v\n", err)
+	}
+}
+
+// useItemInGame pozwala użyć przedmiotu z ekwipunku w trakcie gry i zwraca komunikat o efekcie
+func useItemInGame(consoleUI *ui.ConsoleUI, g *game.Game, rpgLevel *game.RPGLevel) string {
+	// Pokaż tylko przedmioty dozwolone na aktualnym poziomie trudności
+	var items []game.RPGItem
+	for _, item := range rpgLevel.Inventory.GetUsableItems() {
+		if g.Difficulty.AllowsItem(item.ID) {
+			items = append(items, item)
+		}
+	}
+	rpgUI := ui.NewRPGCharacterUI(rpgLevel, nil)
+
+	consoleUI.ClearScreen()
+	consoleUI.PrintGameState(g)
+	rpgUI.PrintUsableItems(consoleUI, items)
+
+	option := consoleUI.GetMenuOption()
+	if option <= 0 || option > len(items) {
+		return ""
+	}
+
+	item := items[option-1]
+	effects, ok := rpgLevel.Inventory.UseItem(item.ID)
+	if !ok {
+		return ui.Red + "Nie można użyć przedmiotu: " + item.Name + ui.Reset
+	}
+
+	// Zastosuj efekty i przygotuj opis tego, co się stało
+	messages := []string{}
+	for _, result := range game.ApplyItemEffects(g, effects) {
+		if !result.Applied {
+			continue
+		}
+
+		switch result.Effect.Type {
+		case "reveal_letter":
+			messages = append(messages, fmt.Sprintf("odkryto literę: %s", string(result.Letters)))
+		case "reveal_hint":
+			messages = append(messages, "odkryto podpowiedź")
+		case "suggest_letter":
+			messages = append(messages, fmt.Sprintf("spróbuj liter: %s", string(result.Letters)))
+		case "extra_life":
+			messages = append(messages, fmt.Sprintf("+%d próby", result.Effect.Value))
+		}
+	}
+
+	if len(messages) == 0 {
+		return ui.Yellow + "Użyto: " + item.Name + " (bez efektu)" + ui.Reset
+	}
+
+	return ui.Green + "Użyto: " + item.Name + " - " + strings.Join(messages, ", ") + ui.Reset
+}
+
+// saveCharacter zapisuje postać i informuje o ewentualnym błędzie
+func saveCharacter(consoleUI *ui.ConsoleUI, characterManager *storage.CharacterManager) {
+	if err := characterManager.Save(); err != nil {
+		fmt.Println(consoleUI.CenterText(ui.Red + fmt.Sprintf("Błąd podczas zapisywania postaci: %v", err) + ui.Reset))
+		consoleUI.WaitForEnter()
+	}
+}
+
+// saveWordBag zapisuje worek słów i informuje o błędzie zapisu
+func saveWordBag(consoleUI *ui.ConsoleUI, wordBagManager *storage.WordBagManager) {
+	if err := wordBagManager.Save(); err != nil {
+		fmt.Println(consoleUI.CenterText(ui.Red + fmt.Sprintf("Błąd podczas zapisywania worka słów: %v", err) + ui.Reset))
+		consoleUI.WaitForEnter()
+	}
+}
+
 // selectDifficulty pozwala wybrać poziom trudności
-func selectDifficulty(consoleUI *ui.ConsoleUI, txt localization.Translations) {
+func selectDifficulty(consoleUI *ui.ConsoleUI, difficultyManager *game.DifficultyManager, txt localization.Translations) {
 	consoleUI.ClearScreen()
-	consoleUI.PrintDifficultyMenu()
+	difficulties := difficultyManager.GetAll()
+	consoleUI.PrintDifficultyMenu(difficulties)
 
 	option := consoleUI.GetMenuOption()
-	if option >= 1 && option <= 3 {
-		difficultyLevel = option
-		fmt.Printf(consoleUI.CenterText(txt.Messages.DifficultySet+" %d\n"), difficultyLevel)
+	if option >= 1 && option <= len(difficulties) {
+		difficultyID = difficulties[option-1].ID
+		fmt.Println(consoleUI.CenterText(txt.Messages.DifficultySet + " " + difficulties[option-1].Name))
 	} else {
 		fmt.Println(consoleUI.CenterText(txt.Messages.DefaultDifficulty))
 	}
@@ -209,7 +495,7 @@ func selectDifficulty(consoleUI *ui.ConsoleUI, txt localization.Translations) {
 }
 
 // showStats wyświetla statystyki gracza
-func showStats(consoleUI *ui.ConsoleUI, statsManager *storage.StatsManager, txt localization.Translations) {
+func showStats(consoleUI *ui.ConsoleUI, statsManager *storage.StatsManager, difficultyManager *game.DifficultyManager, txt localization.Translations) {
 	consoleUI.ClearScreen()
 	fmt.Println(consoleUI.CenterText(ui.Bold + ui.Yellow + "=== " + txt.MainMenu.Statistics + " ===" + ui.Reset))
 
@@ -270,14 +556,19 @@ func showStats(consoleUI *ui.ConsoleUI, statsManager *storage.StatsManager, txt
 				}
 			}
 
-			difficultyText := "Średni"
-			switch game.Difficulty {
-			case 8: // game.EasyLevel
-				difficultyText = txt.DifficultyMenu.Easy
-			case 6: // game.MediumLevel
-				difficultyText = txt.DifficultyMenu.Medium
-			case 4: // game.HardLevel
-				difficultyText = txt.DifficultyMenu.Hard
+			difficultyText := game.GetDifficultyID()
+			if difficulty, ok := difficultyManager.Get(difficultyText); ok {
+				difficultyText = difficulty.Name
+			}
+
+			if game.Scoring != "" {
+				difficultyText += ", " + game.Scoring
+			}
+			if game.Language != "" {
+				difficultyText += ", " + game.Language
+			}
+			if game.Mode != "" {
+				difficultyText += ", " + game.Mode
 			}
 
 			gameText := fmt.Sprintf("%d. %s: %s [%s] - %s%s%s (%d pkt)",
@@ -294,11 +585,32 @@ func showStats(consoleUI *ui.ConsoleUI, statsManager *storage.StatsManager, txt
 		}
 	}
 
+	// Wyświetl wyniki graczy z gier dwuosobowych
+	if records := statsManager.GetPlayerRecords(); len(records) > 0 {
+		fmt.Println(consoleUI.CenterText(ui.Bold + ui.Yellow + "\n=== GRACZE ===" + ui.Reset))
+		for _, record := range records {
+			fmt.Println(consoleUI.CenterText(fmt.Sprintf("%s%s%s: odgadnięte %d/%d, nieodgadnięte słowa %d/%d",
+				ui.Bold, record.Name, ui.Reset,
+				record.WordsGuessed, record.GamesGuessed,
+				record.WordsUnsolved, record.GamesSet)))
+		}
+	}
+
+	// Wyświetl tabelę wyników wyścigów
+	if scores := statsManager.GetRaceScoreboard(); len(scores) > 0 {
+		fmt.Println(consoleUI.CenterText(ui.Bold + ui.Yellow + "\n=== WYŚCIGI ===" + ui.Reset))
+		for i, score := range scores {
+			fmt.Println(consoleUI.CenterText(fmt.Sprintf("%d. %s%s%s: wygrane %d/%d, podium %d, punkty %d",
+				i+1, ui.Bold, score.Name, ui.Reset,
+				score.Wins, score.Races, score.Podiums, score.TotalPoints)))
+		}
+	}
+
 	consoleUI.WaitForEnter()
 }
 
 // selectLanguage pozwala wybrać język
-func selectLanguage(consoleUI *ui.ConsoleUI, langManager *localization.LanguageManager) {
+func selectLanguage(consoleUI *ui.ConsoleUI, langManager *localization.LanguageManager, wordsManager *game.WordsManager) {
 	// Utwórz listę dostępnych języków
 	languages := []string{
 		langManager.Translations[localization.Polish].LanguageSelfName,
@@ -318,6 +630,9 @@ func selectLanguage(consoleUI *ui.ConsoleUI, langManager *localization.LanguageM
 	case 1:
 		langManager.SetLanguage(localization.English)
 	}
+
+	// Przełącz paczkę słów na wybrany język (jeśli istnieje)
+	wordsManager.SetLanguage(string(langManager.CurrentLanguage))
 }
 
 // showItemShop wyświetla sklep z przedmiotami
@@ -336,8 +651,23 @@ func showItemShop(consoleUI *ui.ConsoleUI, rpgLevel *game.RPGLevel, rpgUI *ui.RP
 		return
 	}
 
-	// Logika kupowania przedmiotów mogłaby być tutaj zaimplementowana
-	// Ale na razie ją pomijamy
+	// Kup wybrany przedmiot
+	item := shopItems[option-1]
+	err := rpgLevel.BuyItem(item)
+	switch err {
+	case nil:
+		fmt.Println(consoleUI.CenterText(ui.Bold + ui.Green +
+			fmt.Sprintf("Kupiono: %s (-%d XP)", item.Name, game.GetItemPrice(item)) + ui.Reset))
+	case game.ErrNotEnoughXP:
+		fmt.Println(consoleUI.CenterText(ui.Red +
+			fmt.Sprintf("Za mało XP! Potrzebujesz %d XP, masz %d XP.", game.GetItemPrice(item), rpgLevel.Experience) + ui.Reset))
+	case game.ErrInventoryFull:
+		fmt.Println(consoleUI.CenterText(ui.Red +
+			fmt.Sprintf("Ekwipunek jest pełny (%d/%d)! Nie można kupić przedmiotu.",
+				rpgLevel.Inventory.ItemCount(), rpgLevel.Inventory.MaxCapacity) + ui.Reset))
+	}
+
+	consoleUI.WaitForEnter()
 }
 
 // loadLanguagePreference wczytuje preferencje językowe
@@ -0,0 +1,150 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/r3per/hanged-game/internal/game"
+	"github.com/r3per/hanged-game/internal/storage"
+	"github.com/r3per/hanged-game/internal/ui"
+)
+
+// Liczba graczy w wyścigu przy jednej klawiaturze
+const (
+	MinRacePlayers = 2
+	MaxRacePlayers = 4
+)
+
+// playRace prowadzi wyścig przy jednym komputerze: każdy gracz ma własną grę z tym samym słowem,
+// a gracze na zmianę naciskają po jednym klawiszu (bez przedmiotów i bonusów z atrybutów RPG)
+func playRace(consoleUI *ui.ConsoleUI, wordsManager *game.WordsManager, statsManager *storage.StatsManager, difficulty game.Difficulty, scoring game.ScoringPolicy) {
+	consoleUI.ClearScreen()
+	fmt.Println(consoleUI.CenterText(ui.Bold + ui.Yellow + "=== WYŚCIG ===" + ui.Reset))
+	fmt.Println()
+
+	fmt.Print(consoleUI.CenterText(ui.Bold + fmt.Sprintf("Liczba graczy (%d-%d) [%d]: ", MinRacePlayers, MaxRacePlayers, MinRacePlayers) + ui.Reset))
+	count := MinRacePlayers
+	if input := consoleUI.GetInput(); input != "" {
+		n, err := strconv.Atoi(input)
+		if err != nil || n < MinRacePlayers || n > MaxRacePlayers {
+			fmt.Println(consoleUI.CenterText(ui.Red + "Nieprawidłowa liczba graczy" + ui.Reset))
+			consoleUI.WaitForEnter()
+			return
+		}
+		count = n
+	}
+
+	names := make([]string, 0, count)
+	for i := 1; i <= count; i++ {
+		name := askPlayerName(consoleUI, fmt.Sprintf("Imię gracza %d", i), fmt.Sprintf("Gracz %d", i))
+		for containsName(names, name) {
+			fmt.Println(consoleUI.CenterText(ui.Red + "Ten gracz już bierze udział w wyścigu" + ui.Reset))
+			name = askPlayerName(consoleUI, fmt.Sprintf("Imię gracza %d", i), fmt.Sprintf("Gracz %d", i))
+		}
+		names = append(names, name)
+	}
+
+	template := game.NewGameFromWord(wordsManager.GetRandomWordForDifficulty(difficulty), difficulty, game.GameModifiers{}, scoring)
+	template.SetSeed(wordsManager.NewGameSeed())
+	template.Alphabet.Strict = strictLetters
+	race := game.NewRace(template, names)
+
+	// Litery są odczytywane bez Enter, jeśli terminal na to pozwala;
+	// w przeciwnym razie każdy gracz zatwierdza swoją literę Enterem
+	kr := ui.NewKeyboardReader()
+	lineBuffered := kr.SetLineMode(false) != nil
+	if !lineBuffered {
+		defer kr.SetLineMode(true)
+	}
+
+	message := ""
+	for !race.Over() {
+		printRace(consoleUI, race)
+		if message != "" {
+			fmt.Println(consoleUI.CenterText(ui.Red + message + ui.Reset))
+			message = ""
+		}
+
+		current := race.Players[race.Turn]
+		prompt := ", naciśnij literę (0 - przerwij wyścig): "
+		if lineBuffered {
+			prompt = ", wpisz literę i naciśnij Enter (0 - przerwij wyścig): "
+		}
+		fmt.Print(consoleUI.CenterText(ui.Bold + current.Name + prompt + ui.Reset))
+
+		key := consoleUI.GetKey(lineBuffered)
+		if key == 0 || key == '0' || key == ui.KeyEsc {
+			fmt.Println()
+			fmt.Println(consoleUI.CenterText(ui.Yellow + "Wyścig przerwany - wynik nie zostanie zapisany" + ui.Reset))
+			consoleUI.WaitForEnter()
+			return
+		}
+
+		if !race.Guess(race.Turn, string(key)) {
+			message = fmt.Sprintf("%s: nieprawidłowa lub powtórzona litera %q", current.Name, key)
+			continue
+		}
+		race.NextTurn()
+	}
+	if !lineBuffered {
+		kr.SetLineMode(true)
+	}
+
+	// Wyświetl wynik wyścigu
+	printRace(consoleUI, race)
+	if winner := race.Winner(); winner != nil {
+		fmt.Println(consoleUI.CenterText(ui.BgGreen + ui.Bold + winner.Name + " wygrywa wyścig! Słowo to: " + winner.Game.Word + ui.Reset))
+	} else {
+		fmt.Println(consoleUI.CenterText(ui.BgRed + ui.Bold + "Nikt nie odgadł słowa: " + template.Word + ui.Reset))
+	}
+	fmt.Println()
+	printRaceStandings(consoleUI, race.Standings())
+
+	if err := statsManager.RecordRace(storage.NewRaceRecord(race, "")); err != nil {
+		fmt.Println(consoleUI.CenterText(ui.Red + fmt.Sprintf("Błąd podczas zapisywania statystyk: %v", err) + ui.Reset))
+	}
+
+	consoleUI.WaitForEnter()
+}
+
+// printRace wyświetla stan gier wszystkich uczestników wyścigu
+func printRace(consoleUI *ui.ConsoleUI, race *game.Race) {
+	consoleUI.ClearScreen()
+	fmt.Println(consoleUI.CenterText(ui.Bold + ui.Yellow + "=== WYŚCIG ===" + ui.Reset))
+	if category := race.Players[0].Game.Category; category != "" {
+		fmt.Println(consoleUI.CenterText(ui.Bold + "Kategoria: " + ui.Reset + category))
+	}
+	fmt.Println()
+
+	for i, player := range race.Players {
+		g := player.Game
+		marker := "  "
+		if i == race.Turn && !race.Over() {
+			marker = ui.Green + "▶ " + ui.Reset
+		}
+
+		fmt.Println(consoleUI.CenterText(marker + ui.Bold + player.Name + ui.Reset + "  " + g.GetWordWithGuesses()))
+		fmt.Println(consoleUI.CenterText(fmt.Sprintf("błędne: %s | pozostałe próby: %d | punkty: %d",
+			g.GetWrongGuesses(), g.GetRemainingAttempts(), g.Points)))
+		fmt.Println()
+	}
+}
+
+// printRaceStandings wyświetla klasyfikację wyścigu
+func printRaceStandings(consoleUI *ui.ConsoleUI, standings []game.RaceStanding) {
+	fmt.Println(consoleUI.CenterText(ui.Bold + ui.Yellow + "=== KLASYFIKACJA ===" + ui.Reset))
+	for _, standing := range standings {
+		fmt.Println(consoleUI.CenterText(fmt.Sprintf("%d. %s%s%s - postęp %.0f%%, %d pkt",
+			standing.Rank, ui.Bold, standing.Name, ui.Reset, standing.Progress, standing.Points)))
+	}
+}
+
+// containsName sprawdza czy nazwa jest już na liście
+func containsName(names []string, name string) bool {
+	for _, other := range names {
+		if other == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,80 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/r3per/hanged-game/internal/game"
+	"github.com/r3per/hanged-game/internal/localization"
+	"github.com/r3per/hanged-game/internal/storage"
+	"github.com/r3per/hanged-game/internal/ui"
+)
+
+// playReplay odtwarza n-tą od końca grę ze statystyk: to samo słowo startowe, tryb, poziom trudności,
+// zasady punktacji, modyfikatory i ziarno, więc te same ruchy dają ten sam przebieg gry
+// Odtworzona gra nie używa przedmiotów z ekwipunku i nie jest zapisywana w statystykach
+func playReplay(consoleUI *ui.ConsoleUI, wordsManager *game.WordsManager, difficultyManager *game.DifficultyManager, statsManager *storage.StatsManager, txt localization.Translations, n int) int {
+	record, ok := statsManager.GetGameFromEnd(n)
+	if !ok {
+		fmt.Printf("Brak gry nr %d w statystykach (zapisanych gier: %d)\n", n, len(statsManager.GetStats().GameHistory))
+		return 1
+	}
+	if !record.Replayable() {
+		fmt.Println("Tej gry nie można odtworzyć: zapisała ją starsza wersja gry, bez ustawień rozgrywki")
+		return 1
+	}
+
+	difficulty, ok := difficultyManager.Get(record.GetDifficultyID())
+	if !ok {
+		fmt.Printf("Nieznany poziom trudności: %s\n", record.GetDifficultyID())
+		return 1
+	}
+
+	scoringName := record.Scoring
+	if scoringName == "" {
+		scoringName = game.DefaultScoringPolicy
+	}
+	scoring, ok := game.GetScoringPolicy(scoringName)
+	if !ok {
+		fmt.Printf("Nieznane zasady punktacji: %s\n", scoringName)
+		return 1
+	}
+
+	pack := wordsManager.GetPackWords(record.Language)
+	if pack == nil {
+		fmt.Printf("Brak paczki słów dla języka: %s\n", record.Language)
+		return 1
+	}
+
+	// Słowo z paczki ma kategorię i podpowiedź; słowo spoza paczki (np. usunięte) odtwarzamy bez nich
+	word, ok := wordsManager.FindWord(record.Language, record.GetReplayWord())
+	if !ok {
+		word = game.Word{Text: record.GetReplayWord(), Language: record.Language}
+	}
+
+	g := game.NewSeededGame(word, pack, record.Mode, difficulty, *record.Modifiers, scoring, record.Seed)
+	g.Alphabet.Strict = record.StrictLetters
+	g.AdvisorHints = record.AdvisorHints
+
+	runGameLoop(consoleUI, g, nil)
+
+	// Wyświetl wynik odtworzonej gry obok wyniku oryginału
+	consoleUI.ClearScreen()
+	consoleUI.PrintGameState(g)
+
+	if g.State == game.Won {
+		fmt.Println(consoleUI.CenterText(ui.BgGreen + ui.Bold + txt.Messages.Congratulations + " " + txt.Messages.YouWon + " " + g.Word + ui.Reset))
+	} else {
+		fmt.Println(consoleUI.CenterText(ui.BgRed + ui.Bold + txt.Messages.YouLost + " " + g.Word + ui.Reset))
+	}
+
+	originalResult := "przegrana"
+	if record.Result == "win" {
+		originalResult = "wygrana"
+	}
+	fmt.Println(consoleUI.CenterText(fmt.Sprintf("Odtworzenie: %d pkt (oryginał z %s: %s, %d pkt)",
+		g.Points, record.Date.Format("02.01.2006 15:04"), originalResult, record.Points)))
+	fmt.Println(consoleUI.CenterText(ui.Yellow + "Odtworzona gra nie jest zapisywana w statystykach" + ui.Reset))
+
+	consoleUI.WaitForEnter()
+	return 0
+}
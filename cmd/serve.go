@@ -0,0 +1,283 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"math/rand"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/r3per/hanged-game/internal/game"
+	"github.com/r3per/hanged-game/internal/network"
+	"github.com/r3per/hanged-game/internal/storage"
+	"github.com/r3per/hanged-game/internal/ui"
+)
+
+// Domyślny adres serwera gry sieciowej
+const DefaultServerAddr = ":7777"
+
+// Domyślny plik statystyk serwera gry sieciowej (wyniki wyścigów)
+// (osobny, bo gra w konsoli przechowuje statystyki w pamięci i nadpisałaby wyniki zapisane przez serwer)
+const ServerStatsFilePath = "data/server_stats.json"
+
+// Liczba wyników wyścigów czekających na zapis do pliku statystyk
+const raceRecordQueueSize = 64
+
+// runServeCommand obsługuje podkomendę "serve": serwer TCP z pokojami dla wielu graczy
+func runServeCommand(args []string) int {
+	flags := flag.NewFlagSet("serve", flag.ContinueOnError)
+	addr := flags.String("addr", DefaultServerAddr, "adres, pod którym serwer nasłuchuje")
+	language := flags.String("lang", "pl", "język paczki słów")
+	difficultyName := flags.String("difficulty", game.DefaultDifficulty, "identyfikator poziomu trudności")
+	scoringName := flags.String("scoring", game.DefaultScoringPolicy,
+		"zasady punktacji: "+strings.Join(game.ScoringPolicyNames(), ", "))
+	seed := flags.Int64("seed", 0, "ziarno losowania słów (0 = losowe)")
+	statsPath := flags.String("stats", ServerStatsFilePath, "plik statystyk z wynikami wyścigów")
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+
+	newGame, err := newServerGameFactory(*language, *difficultyName, *scoringName, *seed)
+	if err != nil {
+		fmt.Printf("Nie można uruchomić serwera: %v\n", err)
+		return 1
+	}
+
+	// Wyniki wyścigów trafiają do statystyk (pokoje mogą kończyć wyścigi jednocześnie)
+	if err := os.MkdirAll(filepath.Dir(*statsPath), 0755); err != nil {
+		fmt.Printf("Nie można utworzyć katalogu danych: %v\n", err)
+		return 1
+	}
+	statsManager, err := storage.NewStatsManager(*statsPath)
+	if err != nil {
+		fmt.Printf("Błąd podczas ładowania statystyk: %v\n", err)
+		return 1
+	}
+
+	// Wynik wyścigu jest zapisywany pod blokadą pokoju (a czasem także serwera),
+	// więc plik zapisuje osobna gorutyna, aby wolny dysk nie blokował graczy
+	records := make(chan storage.RaceRecord, raceRecordQueueSize)
+	var writer sync.WaitGroup
+	writer.Add(1)
+	go func() {
+		defer writer.Done()
+		for record := range records {
+			if err := statsManager.RecordRace(record); err != nil {
+				fmt.Printf("Błąd podczas zapisywania wyniku wyścigu: %v\n", err)
+			}
+		}
+	}()
+
+	server := network.NewServer(newGame)
+	server.SetRaceRecorder(func(room string, race *game.Race) {
+		records <- storage.NewRaceRecord(race, room)
+	})
+	// Zamknięcie serwera czeka na obsługę wszystkich połączeń, więc potem nie będzie już nowych wyników
+	defer func() {
+		server.Close()
+		close(records)
+		writer.Wait()
+	}()
+
+	if err := server.Listen(*addr); err != nil {
+		fmt.Printf("Nie można uruchomić serwera: %v\n", err)
+		return 1
+	}
+	fmt.Printf("Serwer gry nasłuchuje na %s (Ctrl+C kończy pracę)\n", server.Addr())
+
+	// Zamknij serwer po przerwaniu (Ctrl+C)
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt)
+	go func() {
+		<-interrupt
+		server.Close()
+	}()
+
+	if err := server.Serve(); err != nil {
+		fmt.Printf("Błąd serwera: %v\n", err)
+		return 1
+	}
+	return 0
+}
+
+// newServerGameFactory przygotowuje funkcję tworzącą gry dla pokoi serwera
+func newServerGameFactory(language, difficultyName, scoringName string, seed int64) (network.GameFactory, error) {
+	scoring, ok := game.GetScoringPolicy(scoringName)
+	if !ok {
+		return nil, fmt.Errorf("nieznane zasady punktacji: %s (dostępne: %s)", scoringName, strings.Join(game.ScoringPolicyNames(), ", "))
+	}
+
+	difficultyManager, err := game.NewDifficultyManager(DifficultyFilePath)
+	if err != nil {
+		return nil, fmt.Errorf("błąd podczas ładowania poziomów trudności: %v", err)
+	}
+	difficulty, ok := difficultyManager.Get(difficultyName)
+	if !ok {
+		return nil, fmt.Errorf("nieznany poziom trudności: %s", difficultyName)
+	}
+
+	wordsManager, err := game.NewWordsManagerFromDir(WordsDirPath, language)
+	if err != nil {
+		return nil, fmt.Errorf("błąd podczas ładowania słów: %v", err)
+	}
+	if seed != 0 {
+		wordsManager.SetRandSource(rand.NewSource(seed))
+	}
+
+	return func() *game.Game {
+		g := game.NewGameFromWord(wordsManager.GetRandomWordForDifficulty(difficulty), difficulty, game.GameModifiers{}, scoring)
+		g.SetSeed(wordsManager.NewGameSeed())
+		g.Alphabet.Strict = strictLetters
+		return g
+	}, nil
+}
+
+// runJoinCommand obsługuje podkomendę "join": klient gry sieciowej w konsoli
+func runJoinCommand(args []string) int {
+	flags := flag.NewFlagSet("join", flag.ContinueOnError)
+	room := flags.String("room", "lobby", "nazwa pokoju (bez spacji)")
+	name := flags.String("name", "", "nazwa gracza")
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if flags.NArg() > 1 {
+		fmt.Println("Użycie: hangman join [opcje] [adres serwera]")
+		return 2
+	}
+
+	addr := "localhost" + DefaultServerAddr
+	if flags.NArg() == 1 {
+		addr = flags.Arg(0)
+	}
+
+	consoleUI := ui.NewConsoleUI()
+	if *name == "" {
+		*name = askPlayerName(consoleUI, "Twoje imię", "Gracz")
+	}
+
+	client, err := network.Dial(addr)
+	if err != nil {
+		fmt.Printf("Nie można połączyć się z serwerem %s: %v\n", addr, err)
+		return 1
+	}
+	defer client.Close()
+
+	if err := client.Join(*room, *name); err != nil {
+		fmt.Printf("Nie można dołączyć do pokoju: %v\n", err)
+		return 1
+	}
+
+	// Wiadomości z serwera są wyświetlane na bieżąco, niezależnie od wpisywania ruchów
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for {
+			message, err := client.Receive()
+			if err != nil {
+				fmt.Println(consoleUI.CenterText(ui.Red + "Połączenie z serwerem zostało zakończone" + ui.Reset))
+				return
+			}
+			printServerMessage(consoleUI, message, *name)
+		}
+	}()
+
+	lines := make(chan string)
+	go func() {
+		for {
+			lines <- consoleUI.GetInput()
+		}
+	}()
+
+	for {
+		select {
+		case <-done:
+			return 0
+		case line := <-lines:
+			switch line {
+			case "":
+				continue
+			case "/quit":
+				return 0
+			case "/new":
+				err = client.Send(network.CmdNew)
+			case "/race":
+				err = client.Send(network.CmdRace)
+			case ui.HintKey:
+				err = client.Send(network.CmdHint)
+			default:
+				err = client.Guess(line)
+			}
+			if err != nil {
+				fmt.Println(consoleUI.CenterText(ui.Red + fmt.Sprintf("Błąd połączenia: %v", err) + ui.Reset))
+				return 1
+			}
+		}
+	}
+}
+
+// printServerMessage wyświetla wiadomość od serwera
+func printServerMessage(consoleUI *ui.ConsoleUI, message network.Message, name string) {
+	switch message.Type {
+	case network.MsgState:
+		state := message.State
+		consoleUI.ClearScreen()
+		consoleUI.PrintGameView(state.Game)
+		fmt.Println(consoleUI.CenterText(ui.Bold + "Pokój: " + ui.Reset + state.Room +
+			ui.Bold + " | Gracze: " + ui.Reset + strings.Join(state.Players, ", ")))
+
+		if state.Race != nil {
+			printRaceState(consoleUI, state, name)
+			return
+		}
+
+		switch {
+		case state.Game.State == game.StateWon:
+			fmt.Println(consoleUI.CenterText(ui.BgGreen + ui.Bold + "Słowo odgadnięte: " + state.Game.Word + ui.Reset))
+			fmt.Print(consoleUI.CenterText(ui.Bold + "/new - nowa gra, /race - wyścig, /quit - wyjście: " + ui.Reset))
+		case state.Game.State == game.StateLost:
+			fmt.Println(consoleUI.CenterText(ui.BgRed + ui.Bold + "Przegrana! Słowo to: " + state.Game.Word + ui.Reset))
+			fmt.Print(consoleUI.CenterText(ui.Bold + "/new - nowa gra, /race - wyścig, /quit - wyjście: " + ui.Reset))
+		case state.Turn == name:
+			fmt.Print(consoleUI.CenterText(ui.Bold + ui.Green + "Twoja kolej! " + ui.Reset + ui.Bold +
+				"Podaj literę lub całe słowo (" + ui.HintKey + fmt.Sprintf(" - podpowiedź za %d pkt, /quit - wyjście): ", game.HintCost) + ui.Reset))
+		default:
+			fmt.Println(consoleUI.CenterText(ui.Yellow + "Kolej gracza " + state.Turn + " (/quit - wyjście)" + ui.Reset))
+		}
+	case network.MsgError:
+		fmt.Println()
+		fmt.Println(consoleUI.CenterText(ui.Red + message.Text + ui.Reset))
+	default:
+		fmt.Println()
+		fmt.Println(consoleUI.CenterText(ui.Cyan + message.Text + ui.Reset))
+	}
+}
+
+// printRaceState wyświetla wyścig: grę gracza, klasyfikację i podpowiedź, co zrobić dalej
+func printRaceState(consoleUI *ui.ConsoleUI, state *network.RoomState, name string) {
+	fmt.Println()
+	printRaceStandings(consoleUI, state.Race.Standings)
+	fmt.Println()
+
+	racing := false
+	for _, standing := range state.Race.Standings {
+		if standing.Name == name {
+			racing = true
+		}
+	}
+
+	switch {
+	case state.Race.Over:
+		fmt.Println(consoleUI.CenterText(ui.Bold + "Wyścig zakończony! Słowo to: " + state.Game.Word + ui.Reset))
+		fmt.Print(consoleUI.CenterText(ui.Bold + "/new - nowa gra, /race - kolejny wyścig, /quit - wyjście: " + ui.Reset))
+	case !racing:
+		fmt.Println(consoleUI.CenterText(ui.Yellow + "Trwa wyścig - oglądasz grę prowadzącego gracza (/quit - wyjście)" + ui.Reset))
+	case state.Game.State != game.StatePlaying:
+		fmt.Println(consoleUI.CenterText(ui.Yellow + "Twoja gra się zakończyła - poczekaj na koniec wyścigu (/quit - wyjście)" + ui.Reset))
+	default:
+		fmt.Print(consoleUI.CenterText(ui.Bold + ui.Green + "Wyścig! " + ui.Reset + ui.Bold +
+			"Podaj literę lub całe słowo (" + ui.HintKey + fmt.Sprintf(" - podpowiedź za %d pkt, /quit - wyjście): ", game.HintCost) + ui.Reset))
+	}
+}
@@ -0,0 +1,88 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/r3per/hanged-game/internal/game"
+	"github.com/r3per/hanged-game/internal/localization"
+	"github.com/r3per/hanged-game/internal/storage"
+	"github.com/r3per/hanged-game/internal/ui"
+)
+
+// playTwoPlayerGame prowadzi grę dwuosobową przy jednym komputerze:
+// pierwszy gracz wpisuje tajne słowo, a drugi je odgaduje (bez przedmiotów i bonusów z atrybutów RPG)
+func playTwoPlayerGame(consoleUI *ui.ConsoleUI, wordsManager *game.WordsManager, statsManager *storage.StatsManager, difficulty game.Difficulty, scoring game.ScoringPolicy, txt localization.Translations) {
+	consoleUI.ClearScreen()
+	fmt.Println(consoleUI.CenterText(ui.Bold + ui.Yellow + "=== GRA DWUOSOBOWA ===" + ui.Reset))
+	fmt.Println()
+
+	setter := askPlayerName(consoleUI, "Imię gracza, który wpisuje słowo", "Gracz 1")
+	guesser := askPlayerName(consoleUI, "Imię gracza, który odgaduje słowo", "Gracz 2")
+
+	// Słowo jest sprawdzane alfabetem i ograniczeniami aktywnej paczki słów
+	filter := game.DefaultWordFilter(wordsManager.GetLanguage())
+
+	var g *game.Game
+	for g == nil {
+		fmt.Println()
+		fmt.Println(consoleUI.CenterText(ui.Bold + guesser + ", odwróć wzrok!" + ui.Reset))
+		fmt.Print(consoleUI.CenterText(ui.Bold + setter + ", wpisz tajne słowo (Enter bez słowa - powrót): " + ui.Reset))
+
+		secret := consoleUI.GetSecretInput()
+		if secret == "" {
+			return
+		}
+
+		var issues []game.WordIssue
+		g, issues = game.NewTwoPlayerGame(secret, filter, difficulty, scoring)
+		for _, issue := range issues {
+			fmt.Println(consoleUI.CenterText(ui.Red + "Słowo odrzucone: " + issue.Detail + ui.Reset))
+		}
+	}
+	g.Alphabet.Strict = strictLetters
+
+	consoleUI.ClearScreen()
+	fmt.Println(consoleUI.CenterText(ui.Bold + ui.Green + "Słowo gotowe. " + guesser + ", Twoja kolej!" + ui.Reset))
+	consoleUI.WaitForEnter()
+
+	runGameLoop(consoleUI, g, nil)
+
+	// Wyświetl wynik gry
+	consoleUI.ClearScreen()
+	consoleUI.PrintGameState(g)
+
+	result := "lose"
+	if g.State == game.Won {
+		result = "win"
+		fmt.Println(consoleUI.CenterText(ui.BgGreen + ui.Bold + txt.Messages.Congratulations + " " + guesser + " odgadł(a) słowo: " + g.Word + ui.Reset))
+	} else {
+		fmt.Println(consoleUI.CenterText(ui.BgRed + ui.Bold + setter + " wygrywa! Słowo to: " + g.Word + ui.Reset))
+	}
+
+	err := statsManager.RecordTwoPlayerGame(storage.GameStats{
+		Word:         g.Word,
+		Result:       result,
+		Points:       g.Points,
+		DifficultyID: g.Difficulty.ID,
+		Scoring:      g.Scoring.Name(),
+		Language:     g.Language,
+		Seed:         g.Seed,
+		Mode:         g.Mode,
+		Setter:       setter,
+		Guesser:      guesser,
+	})
+	if err != nil {
+		fmt.Println(consoleUI.CenterText(ui.Red + fmt.Sprintf("Błąd podczas zapisywania statystyk: %v", err) + ui.Reset))
+	}
+
+	consoleUI.WaitForEnter()
+}
+
+// askPlayerName pobiera imię gracza (lub zwraca domyślne, gdy gracz nic nie wpisze)
+func askPlayerName(consoleUI *ui.ConsoleUI, prompt string, defaultName string) string {
+	fmt.Print(consoleUI.CenterText(ui.Bold + prompt + " [" + defaultName + "]: " + ui.Reset))
+	if name := consoleUI.GetInput(); name != "" {
+		return name
+	}
+	return defaultName
+}
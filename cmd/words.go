@@ -0,0 +1,290 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/r3per/hanged-game/internal/game"
+)
+
+// runWordsCommand obsługuje podkomendę "words" do zarządzania paczkami słów
+func runWordsCommand(args []string) int {
+	if len(args) == 0 {
+		printWordsUsage()
+		return 2
+	}
+
+	switch args[0] {
+	case "lint":
+		return runWordsLint(args[1:])
+	case "import":
+		return runWordsImport(args[1:])
+	case "stats":
+		return runWordsStats(args[1:])
+	default:
+		fmt.Printf("Nieznane polecenie: %s\n", args[0])
+		printWordsUsage()
+		return 2
+	}
+}
+
+// printWordsUsage wyświetla opis podkomendy "words"
+func printWordsUsage() {
+	fmt.Println("Użycie: hangman words <polecenie> [opcje]")
+	fmt.Println()
+	fmt.Println("Polecenia:")
+	fmt.Println("  lint <paczka>             sprawdza paczkę słów (duplikaty, niedozwolone znaki, długość)")
+	fmt.Println("  import <źródło> <paczka>  dołącza słowa z pliku do paczki (znormalizowane, bez duplikatów)")
+	fmt.Println("  stats <paczka>            wyświetla rozkład długości słów i liter")
+	fmt.Println()
+	fmt.Println("Paczka to ścieżka do pliku lub kod języka (np. pl oznacza " + filepath.Join(WordsDirPath, "pl.tsv") + ").")
+}
+
+// newWordFilterFlags tworzy zestaw opcji z ograniczeniami długości słów
+func newWordFilterFlags(name string) (*flag.FlagSet, *game.WordFilter) {
+	filter := game.DefaultWordFilter("")
+	flags := flag.NewFlagSet("words "+name, flag.ContinueOnError)
+	flags.IntVar(&filter.MinLength, "min", filter.MinLength, "minimalna liczba liter w słowie")
+	flags.IntVar(&filter.MaxLength, "max", filter.MaxLength, "maksymalna liczba liter w słowie (0 = bez ograniczenia)")
+	return flags, &filter
+}
+
+// resolveWordPack zamienia kod języka na ścieżkę paczki słów
+func resolveWordPack(pack string) string {
+	if _, err := os.Stat(pack); err == nil || strings.ContainsAny(pack, `./\`) {
+		return pack
+	}
+
+	for _, ext := range []string{".tsv", ".txt"} {
+		path := filepath.Join(WordsDirPath, pack+ext)
+		if _, err := os.Stat(path); err == nil {
+			return path
+		}
+	}
+	return filepath.Join(WordsDirPath, pack+".tsv")
+}
+
+// runWordsLint sprawdza paczkę słów i zwraca kod wyjścia różny od zera, jeśli znaleziono problemy
+func runWordsLint(args []string) int {
+	flags, filter := newWordFilterFlags("lint")
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if flags.NArg() != 1 {
+		fmt.Println("Użycie: hangman words lint [opcje] <paczka>")
+		return 2
+	}
+
+	path := resolveWordPack(flags.Arg(0))
+	filter.Alphabet = game.GetAlphabet(game.PackLanguage(path))
+	words, err := game.ReadRawWords(path)
+	if err != nil {
+		fmt.Printf("Błąd podczas wczytywania paczki: %v\n", err)
+		return 1
+	}
+
+	issues := game.LintWords(words, *filter)
+	for _, issue := range issues {
+		fmt.Printf("%s:%d: %q: %s\n", path, issue.Line, issue.Word, issue.Detail)
+	}
+
+	if len(issues) > 0 {
+		fmt.Printf("Znaleziono problemów: %d (sprawdzono słów: %d)\n", len(issues), len(words))
+		return 1
+	}
+
+	fmt.Printf("Paczka %s jest poprawna (sprawdzono słów: %d)\n", path, len(words))
+	return 0
+}
+
+// runWordsImport dołącza słowa z zewnętrznej listy do paczki
+func runWordsImport(args []string) int {
+	flags, filter := newWordFilterFlags("import")
+	dryRun := flags.Bool("dry-run", false, "tylko wyświetla słowa, które zostałyby dodane")
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if flags.NArg() != 2 {
+		fmt.Println("Użycie: hangman words import [opcje] <źródło> <paczka>")
+		return 2
+	}
+
+	sourcePath := flags.Arg(0)
+	packPath := resolveWordPack(flags.Arg(1))
+	filter.Alphabet = game.GetAlphabet(game.PackLanguage(packPath))
+
+	source, err := game.ReadRawWords(sourcePath)
+	if err != nil {
+		fmt.Printf("Błąd podczas wczytywania listy słów: %v\n", err)
+		return 1
+	}
+
+	// Słowa z paczki (jeśli już istnieje) traktujemy jako duplikaty
+	seen := make(map[string]bool)
+	if _, err := os.Stat(packPath); err == nil {
+		existing, err := game.ReadRawWords(packPath)
+		if err != nil {
+			fmt.Printf("Błąd podczas wczytywania paczki: %v\n", err)
+			return 1
+		}
+		for _, raw := range existing {
+			seen[filter.Alphabet.FoldWord(game.CleanWordText(raw.Raw))] = true
+		}
+	}
+
+	isTSV := game.IsTSVWordsFile(packPath)
+	var lines []string
+	duplicates, rejected := 0, 0
+	for _, raw := range source {
+		word := raw.Word
+		word.Text = game.CleanWordText(word.Text)
+
+		if issues := filter.Check(word.Text); len(issues) > 0 {
+			fmt.Printf("Pominięto %q (linia %d): %s\n", word.Text, raw.Line, issues[0].Detail)
+			rejected++
+			continue
+		}
+
+		key := filter.Alphabet.FoldWord(word.Text)
+		if seen[key] {
+			duplicates++
+			continue
+		}
+		seen[key] = true
+
+		if isTSV {
+			lines = append(lines, formatTSVWord(word))
+		} else {
+			lines = append(lines, word.Text)
+		}
+	}
+
+	if *dryRun {
+		for _, line := range lines {
+			fmt.Println(line)
+		}
+	} else if len(lines) > 0 {
+		if err := appendWordLines(packPath, lines); err != nil {
+			fmt.Printf("Błąd podczas zapisywania paczki: %v\n", err)
+			return 1
+		}
+	}
+
+	fmt.Printf("Dodano słów: %d, pominięto duplikatów: %d, odrzucono: %d\n", len(lines), duplicates, rejected)
+	return 0
+}
+
+// formatTSVWord zapisuje słowo w formacie TSV (bez pustych kolumn na końcu)
+func formatTSVWord(word game.Word) string {
+	columns := []string{word.Text, word.Category, word.Hint, word.Language, strings.Join(word.Tags, ",")}
+	for len(columns) > 1 && columns[len(columns)-1] == "" {
+		columns = columns[:len(columns)-1]
+	}
+	return strings.Join(columns, "\t")
+}
+
+// appendWordLines dopisuje linie na końcu paczki słów
+func appendWordLines(path string, lines []string) error {
+	data, err := os.ReadFile(path)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	var builder strings.Builder
+	if len(data) > 0 && data[len(data)-1] != '\n' {
+		builder.WriteString("\n")
+	}
+	for _, line := range lines {
+		builder.WriteString(line)
+		builder.WriteString("\n")
+	}
+
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	_, err = file.WriteString(builder.String())
+	return err
+}
+
+// runWordsStats wyświetla rozkład długości słów i liter w paczce
+func runWordsStats(args []string) int {
+	flags := flag.NewFlagSet("words stats", flag.ContinueOnError)
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if flags.NArg() != 1 {
+		fmt.Println("Użycie: hangman words stats <paczka>")
+		return 2
+	}
+
+	path := resolveWordPack(flags.Arg(0))
+	words, err := game.LoadWordsFile(path)
+	if err != nil {
+		fmt.Printf("Błąd podczas wczytywania paczki: %v\n", err)
+		return 1
+	}
+
+	// Litery liczone są według alfabetu paczki, tak jak w grze
+	alphabet := game.GetAlphabet(game.PackLanguage(path))
+	lengths := make(map[int]int)
+	letters := make(map[rune]int)
+	totalLetters := 0
+	for _, word := range words {
+		lengths[alphabet.CountLetters(word.Text)]++
+		for _, char := range word.Text {
+			if alphabet.IsLetter(char) {
+				letters[unicode.ToLower(char)]++
+				totalLetters++
+			}
+		}
+	}
+
+	fmt.Printf("Paczka: %s\n", path)
+	fmt.Printf("Liczba słów: %d\n", len(words))
+
+	fmt.Println("\nRozkład długości (liczba liter):")
+	lengthKeys := make([]int, 0, len(lengths))
+	for length := range lengths {
+		lengthKeys = append(lengthKeys, length)
+	}
+	sort.Ints(lengthKeys)
+	for _, length := range lengthKeys {
+		count := lengths[length]
+		fmt.Printf("  %3d: %4d %s\n", length, count, strings.Repeat("#", scaleBar(count, len(words))))
+	}
+
+	fmt.Println("\nRozkład liter:")
+	letterKeys := make([]rune, 0, len(letters))
+	for letter := range letters {
+		letterKeys = append(letterKeys, letter)
+	}
+	sort.Slice(letterKeys, func(i, j int) bool {
+		if letters[letterKeys[i]] != letters[letterKeys[j]] {
+			return letters[letterKeys[i]] > letters[letterKeys[j]]
+		}
+		return letterKeys[i] < letterKeys[j]
+	})
+	for _, letter := range letterKeys {
+		count := letters[letter]
+		fmt.Printf("  %c: %5d (%5.2f%%) %s\n", letter, count, float64(count)*100/float64(totalLetters),
+			strings.Repeat("#", scaleBar(count, totalLetters)))
+	}
+
+	return 0
+}
+
+// scaleBar zwraca długość paska histogramu dla podanej wartości
+func scaleBar(count, total int) int {
+	if total == 0 {
+		return 0
+	}
+	return (count*100/total + 1) / 2
+}
@@ -0,0 +1,174 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/r3per/hanged-game/internal/game"
+)
+
+// Rozmiar kolejki zdarzeń jednego obserwatora; wolniejszy obserwator zostaje rozłączony
+// i może wznowić strumień od ostatniego odebranego zdarzenia (nagłówek Last-Event-ID)
+const subscriberQueueSize = 64
+
+// newGameSession tworzy grę w pamięci serwera i zaczyna zapisywać jej zdarzenia
+func newGameSession(id string, g *game.Game) *gameSession {
+	session := &gameSession{
+		id:          id,
+		game:        g,
+		events:      []game.GameEvent{g.StartEvent()},
+		subscribers: make(map[chan game.GameEvent]bool),
+	}
+	g.AddListener(session.addEvent)
+	return session
+}
+
+// addEvent zapisuje zdarzenie w historii i przekazuje je obserwatorom
+// (wywoływane pod blokadą serwera, bo gra zmienia się tylko pod nią)
+func (session *gameSession) addEvent(event game.GameEvent) {
+	session.events = append(session.events, event)
+
+	for subscriber := range session.subscribers {
+		select {
+		case subscriber <- event:
+		default:
+			delete(session.subscribers, subscriber)
+			close(subscriber)
+		}
+	}
+
+	// Po zakończeniu gry nie będzie już kolejnych zdarzeń
+	if event.Kind == game.EventState && event.State != game.StatePlaying {
+		session.closeSubscribers()
+	}
+}
+
+// subscribe zwraca zdarzenia nowsze niż since oraz kanał kolejnych zdarzeń
+// (nil, jeśli gra się zakończyła i nic więcej się nie wydarzy)
+func (session *gameSession) subscribe(since int) ([]game.GameEvent, chan game.GameEvent) {
+	var history []game.GameEvent
+	for _, event := range session.events {
+		if event.Seq > since {
+			history = append(history, event)
+		}
+	}
+
+	if session.game.State != game.Playing {
+		return history, nil
+	}
+
+	subscriber := make(chan game.GameEvent, subscriberQueueSize)
+	session.subscribers[subscriber] = true
+	return history, subscriber
+}
+
+// unsubscribe odłącza obserwatora (jeśli nie został już odłączony)
+func (session *gameSession) unsubscribe(subscriber chan game.GameEvent) {
+	if session.subscribers[subscriber] {
+		delete(session.subscribers, subscriber)
+		close(subscriber)
+	}
+}
+
+// closeSubscribers kończy strumienie wszystkich obserwatorów gry
+func (session *gameSession) closeSubscribers() {
+	for subscriber := range session.subscribers {
+		delete(session.subscribers, subscriber)
+		close(subscriber)
+	}
+}
+
+// handleEvents przesyła zdarzenia gry jako Server-Sent Events:
+// najpierw całą historię (lub jej część po Last-Event-ID), potem kolejne zdarzenia na żywo
+func (s *Server) handleEvents(w http.ResponseWriter, r *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		writeError(w, http.StatusInternalServerError, "serwer nie obsługuje strumieniowania")
+		return
+	}
+
+	since, err := lastEventID(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	s.mu.Lock()
+	session, ok := s.lookupLocked(w, r)
+	if !ok {
+		s.mu.Unlock()
+		return
+	}
+	history, subscriber := session.subscribe(since)
+	s.mu.Unlock()
+
+	if subscriber != nil {
+		defer func() {
+			s.mu.Lock()
+			session.unsubscribe(subscriber)
+			s.mu.Unlock()
+		}()
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+
+	for _, event := range history {
+		if err := writeEvent(w, event); err != nil {
+			return
+		}
+	}
+	flusher.Flush()
+
+	if subscriber == nil {
+		return
+	}
+
+	for {
+		select {
+		case event, ok := <-subscriber:
+			if !ok {
+				return
+			}
+			if err := writeEvent(w, event); err != nil {
+				return
+			}
+			flusher.Flush()
+		case <-r.Context().Done():
+			return
+		case <-s.stop:
+			return
+		}
+	}
+}
+
+// lastEventID odczytuje numer ostatniego odebranego zdarzenia
+// (nagłówek Last-Event-ID przy wznowieniu lub parametr since); -1 oznacza całą historię
+func lastEventID(r *http.Request) (int, error) {
+	value := r.Header.Get("Last-Event-ID")
+	if value == "" {
+		value = r.URL.Query().Get("since")
+	}
+	if value == "" {
+		return -1, nil
+	}
+
+	seq, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("nieprawidłowy numer zdarzenia: %s", value)
+	}
+	return seq, nil
+}
+
+// writeEvent zapisuje zdarzenie w formacie Server-Sent Events
+func writeEvent(w http.ResponseWriter, event game.GameEvent) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", event.Seq, event.Kind, data)
+	return err
+}
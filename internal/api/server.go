@@ -0,0 +1,427 @@
+package api
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/r3per/hanged-game/internal/game"
+	"github.com/r3per/hanged-game/internal/storage"
+)
+
+// Domyślny czas, po którym nieużywana gra jest usuwana z pamięci
+const DefaultGameTTL = 30 * time.Minute
+
+// Maksymalny rozmiar treści żądania
+const maxRequestSize = 4 * 1024
+
+// GameFactory tworzy nową grę na podanym poziomie trudności (pusty identyfikator = domyślny poziom)
+// Serwer wywołuje ją pod własną blokadą, więc nie musi ona być bezpieczna współbieżnie
+type GameFactory func(difficultyID string) (*game.Game, error)
+
+// Server udostępnia silnik gry jako REST API w formacie JSON
+type Server struct {
+	mu      sync.Mutex
+	games   map[string]*gameSession
+	newGame GameFactory
+	stats   *storage.StatsManager // Może być nil - wtedy wyniki nie są zapisywane
+	statsMu sync.Mutex            // Chroni zapis statystyk
+	ttl     time.Duration
+	now     func() time.Time
+	mux     *http.ServeMux
+	stop    chan struct{}
+	once    sync.Once
+}
+
+// gameSession to gra przechowywana w pamięci serwera
+type gameSession struct {
+	id        string
+	game      *game.Game
+	expiresAt time.Time
+	recorded  bool // Czy wynik został już zapisany w statystykach
+
+	events      []game.GameEvent             // Pełna historia zdarzeń gry (dla obserwatorów)
+	subscribers map[chan game.GameEvent]bool // Obserwatorzy śledzący grę na żywo
+}
+
+// NewServer tworzy serwer API; gry nieużywane dłużej niż ttl są usuwane
+func NewServer(newGame GameFactory, stats *storage.StatsManager, ttl time.Duration) *Server {
+	if ttl <= 0 {
+		ttl = DefaultGameTTL
+	}
+
+	s := &Server{
+		games:   make(map[string]*gameSession),
+		newGame: newGame,
+		stats:   stats,
+		ttl:     ttl,
+		now:     time.Now,
+		mux:     http.NewServeMux(),
+		stop:    make(chan struct{}),
+	}
+
+	s.mux.HandleFunc("POST /games", s.handleCreate)
+	s.mux.HandleFunc("GET /games/{id}", s.handleState)
+	s.mux.HandleFunc("POST /games/{id}/guess", s.handleGuess)
+	s.mux.HandleFunc("POST /games/{id}/hint", s.handleHint)
+	s.mux.HandleFunc("GET /games/{id}/result", s.handleResult)
+	s.mux.HandleFunc("GET /games/{id}/events", s.handleEvents)
+	s.mux.HandleFunc("DELETE /games/{id}", s.handleDelete)
+
+	go s.expireLoop()
+	return s
+}
+
+// ServeHTTP obsługuje żądanie HTTP
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mux.ServeHTTP(w, r)
+}
+
+// Close zatrzymuje usuwanie wygasłych gier i kończy strumienie zdarzeń
+func (s *Server) Close() {
+	s.once.Do(func() { close(s.stop) })
+}
+
+// CreateRequest to treść żądania utworzenia gry
+type CreateRequest struct {
+	Difficulty string `json:"difficulty,omitempty"` // Identyfikator poziomu trudności
+}
+
+// GuessRequest to treść ruchu: litera albo całe słowo
+type GuessRequest struct {
+	Letter string `json:"letter,omitempty"`
+	Word   string `json:"word,omitempty"`
+}
+
+// GameResponse opisuje grę zwracaną przez API
+type GameResponse struct {
+	ID         string        `json:"id"`
+	Difficulty string        `json:"difficulty"`
+	Scoring    string        `json:"scoring"`
+	ExpiresAt  time.Time     `json:"expires_at"`
+	Game       game.GameView `json:"game"`
+}
+
+// ResultResponse opisuje wynik zakończonej gry
+type ResultResponse struct {
+	ID           string `json:"id"`
+	Result       string `json:"result"` // "win" lub "lose"
+	Word         string `json:"word"`
+	Points       int    `json:"points"`
+	Difficulty   string `json:"difficulty"`
+	Scoring      string `json:"scoring"`
+	Language     string `json:"language,omitempty"`
+	Seed         int64  `json:"seed,string,omitempty"` // Jako tekst, bo klienci JavaScript tracą precyzję liczb powyżej 2^53
+	UsedAttempts int    `json:"used_attempts"`
+	MaxAttempts  int    `json:"max_attempts"`
+	Moves        int    `json:"moves"`
+}
+
+// errorResponse to treść odpowiedzi z błędem
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+// handleCreate tworzy nową grę
+func (s *Server) handleCreate(w http.ResponseWriter, r *http.Request) {
+	var request CreateRequest
+	if r.ContentLength != 0 {
+		if err := decodeRequest(r, &request); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+	}
+
+	id, err := newID()
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "nie można utworzyć identyfikatora gry")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	g, err := s.newGame(request.Difficulty)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	session := newGameSession(id, g)
+	s.games[id] = session
+	s.touchLocked(session)
+
+	writeJSON(w, http.StatusCreated, s.responseLocked(session))
+}
+
+// handleState zwraca aktualny stan gry
+func (s *Server) handleState(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	session, ok := s.lookupLocked(w, r)
+	if !ok {
+		return
+	}
+	writeJSON(w, http.StatusOK, s.responseLocked(session))
+}
+
+// handleGuess wykonuje ruch gracza
+func (s *Server) handleGuess(w http.ResponseWriter, r *http.Request) {
+	var request GuessRequest
+	if err := decodeRequest(r, &request); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	letter := strings.TrimSpace(request.Letter)
+	word := strings.TrimSpace(request.Word)
+	if (letter == "") == (word == "") {
+		writeError(w, http.StatusBadRequest, "podaj literę (letter) albo całe słowo (word)")
+		return
+	}
+	if letter != "" && utf8.RuneCountInString(letter) != 1 {
+		writeError(w, http.StatusBadRequest, "pole letter musi zawierać jedną literę")
+		return
+	}
+
+	s.mu.Lock()
+	session, ok := s.lookupLocked(w, r)
+	if !ok {
+		s.mu.Unlock()
+		return
+	}
+	if session.game.State != game.Playing {
+		s.mu.Unlock()
+		writeError(w, http.StatusConflict, "gra została już zakończona")
+		return
+	}
+
+	var accepted bool
+	if letter != "" {
+		char, _ := utf8.DecodeRuneInString(letter)
+		accepted = session.game.Guess(char)
+	} else {
+		accepted = session.game.GuessWord(word)
+	}
+	if !accepted {
+		s.mu.Unlock()
+		writeError(w, http.StatusUnprocessableEntity, "nieprawidłowy lub powtórzony ruch")
+		return
+	}
+
+	gameStats, finished := s.finishLocked(session)
+	response := s.responseLocked(session)
+	s.mu.Unlock()
+
+	if finished {
+		s.record(gameStats)
+	}
+	writeJSON(w, http.StatusOK, response)
+}
+
+// handleHint odkrywa podpowiedź za punkty
+func (s *Server) handleHint(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	session, ok := s.lookupLocked(w, r)
+	if !ok {
+		return
+	}
+	if session.game.State != game.Playing {
+		writeError(w, http.StatusConflict, "gra została już zakończona")
+		return
+	}
+	if !session.game.RevealHint(game.HintCost) {
+		writeError(w, http.StatusUnprocessableEntity, "podpowiedź jest niedostępna")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, s.responseLocked(session))
+}
+
+// handleResult zwraca wynik zakończonej gry
+func (s *Server) handleResult(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	session, ok := s.lookupLocked(w, r)
+	if !ok {
+		return
+	}
+	g := session.game
+	if g.State == game.Playing {
+		writeError(w, http.StatusConflict, "gra jeszcze trwa")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, ResultResponse{
+		ID:           session.id,
+		Result:       gameResult(g),
+		Word:         g.Word,
+		Points:       g.Points,
+		Difficulty:   g.Difficulty.ID,
+		Scoring:      g.Scoring.Name(),
+		Language:     g.Language,
+		Seed:         g.Seed,
+		UsedAttempts: g.UsedAttempts(),
+		MaxAttempts:  g.MaxAttempts,
+		Moves:        len(g.Moves),
+	})
+}
+
+// handleDelete usuwa grę z pamięci (niedokończona gra nie trafia do statystyk)
+func (s *Server) handleDelete(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	session, ok := s.lookupLocked(w, r)
+	if !ok {
+		return
+	}
+	delete(s.games, session.id)
+	session.closeSubscribers()
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// lookupLocked odnajduje grę z adresu żądania i przedłuża jej ważność
+// (w razie błędu wysyła odpowiedź i zwraca false)
+func (s *Server) lookupLocked(w http.ResponseWriter, r *http.Request) (*gameSession, bool) {
+	session, ok := s.games[r.PathValue("id")]
+	if !ok || !s.now().Before(session.expiresAt) {
+		writeError(w, http.StatusNotFound, "nie znaleziono gry")
+		return nil, false
+	}
+	s.touchLocked(session)
+	return session, true
+}
+
+// touchLocked przedłuża ważność gry
+func (s *Server) touchLocked(session *gameSession) {
+	session.expiresAt = s.now().Add(s.ttl)
+}
+
+// finishLocked zwraca wpis statystyk zakończonej gry (tylko raz dla każdej gry)
+func (s *Server) finishLocked(session *gameSession) (storage.GameStats, bool) {
+	g := session.game
+	if session.recorded || g.State == game.Playing {
+		return storage.GameStats{}, false
+	}
+	session.recorded = true
+
+	return storage.GameStats{
+		Word:         g.Word,
+		Result:       gameResult(g),
+		Points:       g.Points,
+		DifficultyID: g.Difficulty.ID,
+		Scoring:      g.Scoring.Name(),
+		Language:     g.Language,
+		Seed:         g.Seed,
+		Mode:         g.Mode,
+	}, true
+}
+
+// record zapisuje wynik gry w statystykach
+// Zapis do pliku odbywa się bez blokady serwera, aby nie wstrzymywać innych gier
+func (s *Server) record(gameStats storage.GameStats) {
+	if s.stats == nil {
+		return
+	}
+
+	s.statsMu.Lock()
+	defer s.statsMu.Unlock()
+	if err := s.stats.RecordGame(gameStats); err != nil {
+		log.Printf("Błąd podczas zapisywania statystyk: %v", err)
+	}
+}
+
+// responseLocked przygotowuje opis gry dla klienta
+func (s *Server) responseLocked(session *gameSession) GameResponse {
+	return GameResponse{
+		ID:         session.id,
+		Difficulty: session.game.Difficulty.ID,
+		Scoring:    session.game.Scoring.Name(),
+		ExpiresAt:  session.expiresAt,
+		Game:       session.game.View(),
+	}
+}
+
+// expireLoop co jakiś czas usuwa wygasłe gry
+func (s *Server) expireLoop() {
+	interval := s.ttl / 2
+	if interval > time.Minute {
+		interval = time.Minute
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.stop:
+			return
+		case <-ticker.C:
+			s.removeExpired()
+		}
+	}
+}
+
+// removeExpired usuwa gry, których ważność minęła
+func (s *Server) removeExpired() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	for id, session := range s.games {
+		if !now.Before(session.expiresAt) {
+			delete(s.games, id)
+			session.closeSubscribers()
+		}
+	}
+}
+
+// gameResult zwraca wynik gry w formacie statystyk
+func gameResult(g *game.Game) string {
+	if g.State == game.Won {
+		return "win"
+	}
+	return "lose"
+}
+
+// newID tworzy losowy, nieprzewidywalny identyfikator gry
+func newID() (string, error) {
+	data := make([]byte, 16)
+	if _, err := rand.Read(data); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(data), nil
+}
+
+// decodeRequest odczytuje treść żądania w formacie JSON
+func decodeRequest(r *http.Request, target any) error {
+	decoder := json.NewDecoder(http.MaxBytesReader(nil, r.Body, maxRequestSize))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(target); err != nil {
+		return errors.New("nieprawidłowa treść żądania: " + err.Error())
+	}
+	return nil
+}
+
+// writeJSON wysyła odpowiedź w formacie JSON
+func writeJSON(w http.ResponseWriter, status int, value any) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(value)
+}
+
+// writeError wysyła odpowiedź z błędem
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, errorResponse{Error: message})
+}
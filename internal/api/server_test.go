@@ -0,0 +1,390 @@
+package api
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/r3per/hanged-game/internal/game"
+	"github.com/r3per/hanged-game/internal/storage"
+)
+
+// Ziarno gier testowych (większe niż 2^53, aby sprawdzić zapis jako tekst)
+const testSeed = 1<<60 + 1
+
+// newTestGame tworzy grę ze stałym słowem na poziomie "medium"
+func newTestGame(difficultyID string) (*game.Game, error) {
+	if difficultyID != "" && difficultyID != "medium" {
+		return nil, fmt.Errorf("nieznany poziom trudności: %s", difficultyID)
+	}
+
+	word := game.Word{Text: "kot", Language: "pl"}
+	g := game.NewGameFromWord(word, game.Difficulty{ID: "medium", Attempts: 6}, game.GameModifiers{}, nil)
+	g.SetSeed(testSeed)
+	return g, nil
+}
+
+// testAPI to serwer API uruchomiony na potrzeby testu
+type testAPI struct {
+	server  *Server
+	http    *httptest.Server
+	stats   *storage.StatsManager
+	current time.Time // Czas serwera (chroniony blokadą serwera, tak jak odczyty s.now)
+}
+
+// startTestAPI uruchamia serwer API ze statystykami w katalogu tymczasowym i zegarem sterowanym przez test
+func startTestAPI(t *testing.T) *testAPI {
+	t.Helper()
+
+	stats, err := storage.NewStatsManager(filepath.Join(t.TempDir(), "stats.json"))
+	if err != nil {
+		t.Fatalf("NewStatsManager: %v", err)
+	}
+
+	api := &testAPI{
+		server:  NewServer(newTestGame, stats, time.Minute),
+		stats:   stats,
+		current: time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC),
+	}
+	api.server.mu.Lock()
+	api.server.now = func() time.Time { return api.current }
+	api.server.mu.Unlock()
+
+	api.http = httptest.NewServer(api.server)
+	t.Cleanup(func() {
+		api.server.Close()
+		api.http.Close()
+	})
+	return api
+}
+
+// advance przesuwa zegar serwera
+func (api *testAPI) advance(d time.Duration) {
+	api.server.mu.Lock()
+	api.current = api.current.Add(d)
+	api.server.mu.Unlock()
+}
+
+// do wysyła żądanie i zwraca kod odpowiedzi; treść odpowiedzi JSON trafia do target (jeśli podany)
+func (api *testAPI) do(t *testing.T, method, path, body string, target any) int {
+	t.Helper()
+
+	request, err := http.NewRequest(method, api.http.URL+path, strings.NewReader(body))
+	if err != nil {
+		t.Fatalf("NewRequest: %v", err)
+	}
+	response, err := api.http.Client().Do(request)
+	if err != nil {
+		t.Fatalf("%s %s: %v", method, path, err)
+	}
+	defer response.Body.Close()
+
+	if target != nil {
+		if err := json.NewDecoder(response.Body).Decode(target); err != nil {
+			t.Fatalf("%s %s: nieprawidłowa odpowiedź JSON: %v", method, path, err)
+		}
+	}
+	return response.StatusCode
+}
+
+// create tworzy grę i zwraca jej identyfikator
+func (api *testAPI) create(t *testing.T) string {
+	t.Helper()
+
+	var created GameResponse
+	if status := api.do(t, http.MethodPost, "/games", "", &created); status != http.StatusCreated {
+		t.Fatalf("POST /games: status %d, oczekiwano %d", status, http.StatusCreated)
+	}
+	return created.ID
+}
+
+// guess wykonuje ruch i sprawdza kod odpowiedzi
+func (api *testAPI) guess(t *testing.T, id, body string, want int) GameResponse {
+	t.Helper()
+
+	var response GameResponse
+	var target any = &response
+	if want != http.StatusOK {
+		target = nil
+	}
+	if status := api.do(t, http.MethodPost, "/games/"+id+"/guess", body, target); status != want {
+		t.Fatalf("POST /games/%s/guess %s: status %d, oczekiwano %d", id, body, status, want)
+	}
+	return response
+}
+
+func TestCreateGuessResult(t *testing.T) {
+	api := startTestAPI(t)
+
+	var created GameResponse
+	if status := api.do(t, http.MethodPost, "/games", `{"difficulty": "hard"}`, nil); status != http.StatusBadRequest {
+		t.Errorf("gra na nieznanym poziomie: status %d, oczekiwano %d", status, http.StatusBadRequest)
+	}
+	if status := api.do(t, http.MethodPost, "/games", `{"difficulty": "medium"}`, &created); status != http.StatusCreated {
+		t.Fatalf("POST /games: status %d, oczekiwano %d", status, http.StatusCreated)
+	}
+	if created.ID == "" || created.Difficulty != "medium" || created.Game.Pattern != "_ _ _" || created.Game.Word != "" {
+		t.Fatalf("utworzona gra = %+v", created)
+	}
+	id := created.ID
+
+	if status := api.do(t, http.MethodGet, "/games/"+id+"/result", "", nil); status != http.StatusConflict {
+		t.Errorf("wynik trwającej gry: status %d, oczekiwano %d", status, http.StatusConflict)
+	}
+
+	api.guess(t, id, `{}`, http.StatusBadRequest)
+	api.guess(t, id, `{"letter": "ko"}`, http.StatusBadRequest)
+	api.guess(t, id, `{"letter": "k", "word": "kot"}`, http.StatusBadRequest)
+
+	state := api.guess(t, id, `{"letter": "k"}`, http.StatusOK)
+	if state.Game.Pattern != "k _ _" {
+		t.Errorf("Pattern = %q, oczekiwano %q", state.Game.Pattern, "k _ _")
+	}
+	api.guess(t, id, `{"letter": "k"}`, http.StatusUnprocessableEntity)
+
+	state = api.guess(t, id, `{"letter": "x"}`, http.StatusOK)
+	if state.Game.WrongGuesses != "x" || state.Game.RemainingAttempts != 5 {
+		t.Errorf("widok gry po błędzie = %+v", state.Game)
+	}
+
+	state = api.guess(t, id, `{"word": "kot"}`, http.StatusOK)
+	if state.Game.State != game.StateWon || state.Game.Word != "kot" {
+		t.Errorf("widok gry po odgadnięciu słowa = %+v", state.Game)
+	}
+	api.guess(t, id, `{"letter": "a"}`, http.StatusConflict)
+
+	// Ziarno jest wysyłane jako tekst
+	request, _ := http.NewRequest(http.MethodGet, api.http.URL+"/games/"+id+"/result", nil)
+	response, err := api.http.Client().Do(request)
+	if err != nil {
+		t.Fatalf("GET result: %v", err)
+	}
+	data, _ := io.ReadAll(response.Body)
+	response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		t.Fatalf("GET result: status %d, oczekiwano %d", response.StatusCode, http.StatusOK)
+	}
+	if want := `"seed":"` + strconv.FormatInt(testSeed, 10) + `"`; !strings.Contains(string(data), want) {
+		t.Errorf("wynik %s nie zawiera %s", data, want)
+	}
+
+	var result ResultResponse
+	if err := json.Unmarshal(data, &result); err != nil {
+		t.Fatalf("nieprawidłowy wynik: %v", err)
+	}
+	if result.Result != "win" || result.Word != "kot" || result.Seed != testSeed || result.Moves != 3 {
+		t.Errorf("wynik = %+v", result)
+	}
+
+	stats := api.stats.GetStats()
+	if stats.GamesPlayed != 1 || stats.GamesWon != 1 || len(stats.GameHistory) != 1 {
+		t.Errorf("statystyki po grze = %+v, oczekiwano jednej wygranej", stats)
+	}
+	if recorded := stats.GameHistory[0]; recorded.Word != "kot" || recorded.Seed != testSeed {
+		t.Errorf("zapisana gra = %+v", recorded)
+	}
+}
+
+func TestDeleteAndExpiry(t *testing.T) {
+	api := startTestAPI(t)
+
+	id := api.create(t)
+	if status := api.do(t, http.MethodDelete, "/games/"+id, "", nil); status != http.StatusNoContent {
+		t.Errorf("DELETE: status %d, oczekiwano %d", status, http.StatusNoContent)
+	}
+	if status := api.do(t, http.MethodGet, "/games/"+id, "", nil); status != http.StatusNotFound {
+		t.Errorf("usunięta gra: status %d, oczekiwano %d", status, http.StatusNotFound)
+	}
+
+	// Każde żądanie przedłuża ważność gry
+	id = api.create(t)
+	api.advance(50 * time.Second)
+	if status := api.do(t, http.MethodGet, "/games/"+id, "", nil); status != http.StatusOK {
+		t.Fatalf("gra przed wygaśnięciem: status %d, oczekiwano %d", status, http.StatusOK)
+	}
+	api.advance(50 * time.Second)
+	if status := api.do(t, http.MethodGet, "/games/"+id, "", nil); status != http.StatusOK {
+		t.Fatalf("gra po przedłużeniu ważności: status %d, oczekiwano %d", status, http.StatusOK)
+	}
+
+	api.advance(time.Minute)
+	if status := api.do(t, http.MethodGet, "/games/"+id, "", nil); status != http.StatusNotFound {
+		t.Errorf("wygasła gra: status %d, oczekiwano %d", status, http.StatusNotFound)
+	}
+	api.guess(t, id, `{"letter": "k"}`, http.StatusNotFound)
+
+	api.server.removeExpired()
+	api.server.mu.Lock()
+	remaining := len(api.server.games)
+	api.server.mu.Unlock()
+	if remaining != 0 {
+		t.Errorf("po usunięciu wygasłych gier zostało %d gier", remaining)
+	}
+	if played := api.stats.GetStats().GamesPlayed; played != 0 {
+		t.Errorf("GamesPlayed = %d, niedokończone gry nie powinny trafiać do statystyk", played)
+	}
+}
+
+// openEvents otwiera strumień zdarzeń gry (lastEventID < 0 - cała historia)
+func (api *testAPI) openEvents(t *testing.T, id string, lastEventID int) (*bufio.Reader, func()) {
+	t.Helper()
+
+	request, err := http.NewRequest(http.MethodGet, api.http.URL+"/games/"+id+"/events", nil)
+	if err != nil {
+		t.Fatalf("NewRequest: %v", err)
+	}
+	if lastEventID >= 0 {
+		request.Header.Set("Last-Event-ID", strconv.Itoa(lastEventID))
+	}
+
+	response, err := api.http.Client().Do(request)
+	if err != nil {
+		t.Fatalf("GET events: %v", err)
+	}
+	if response.StatusCode != http.StatusOK {
+		response.Body.Close()
+		t.Fatalf("GET events: status %d, oczekiwano %d", response.StatusCode, http.StatusOK)
+	}
+	if contentType := response.Header.Get("Content-Type"); contentType != "text/event-stream" {
+		t.Errorf("Content-Type = %q, oczekiwano text/event-stream", contentType)
+	}
+	return bufio.NewReader(response.Body), func() { response.Body.Close() }
+}
+
+// readEvent odczytuje jedno zdarzenie ze strumienia (io.EOF po zakończeniu strumienia)
+func readEvent(reader *bufio.Reader) (game.GameEvent, error) {
+	var event game.GameEvent
+	fields := make(map[string]string)
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			if errors.Is(err, io.EOF) && len(fields) == 0 {
+				return event, io.EOF
+			}
+			return event, err
+		}
+
+		line = strings.TrimSuffix(line, "\n")
+		if line == "" {
+			break
+		}
+		name, value, _ := strings.Cut(line, ": ")
+		fields[name] = value
+	}
+
+	if err := json.Unmarshal([]byte(fields["data"]), &event); err != nil {
+		return event, err
+	}
+	if fields["id"] != strconv.Itoa(event.Seq) || fields["event"] != event.Kind {
+		return event, fmt.Errorf("nagłówki zdarzenia %v nie zgadzają się z treścią %+v", fields, event)
+	}
+	return event, nil
+}
+
+// readAllEvents odczytuje zdarzenia aż do końca strumienia
+func readAllEvents(t *testing.T, reader *bufio.Reader) []game.GameEvent {
+	t.Helper()
+
+	var events []game.GameEvent
+	for {
+		event, err := readEvent(reader)
+		if errors.Is(err, io.EOF) {
+			return events
+		}
+		if err != nil {
+			t.Fatalf("readEvent: %v", err)
+		}
+		events = append(events, event)
+	}
+}
+
+func TestEventsCatchUp(t *testing.T) {
+	api := startTestAPI(t)
+	id := api.create(t)
+	api.guess(t, id, `{"letter": "k"}`, http.StatusOK)
+	api.guess(t, id, `{"letter": "x"}`, http.StatusOK)
+	api.guess(t, id, `{"word": "kot"}`, http.StatusOK)
+
+	// Po zakończeniu gry strumień zawiera całą historię i od razu się kończy
+	reader, closeStream := api.openEvents(t, id, -1)
+	history := readAllEvents(t, reader)
+	closeStream()
+
+	if len(history) < 4 || history[0].Kind != game.EventStart {
+		t.Fatalf("historia zdarzeń = %+v, oczekiwano zdarzenia start i kolejnych ruchów", history)
+	}
+	for i, event := range history {
+		if event.Seq != i {
+			t.Errorf("zdarzenie %d ma numer %d", i, event.Seq)
+		}
+	}
+	last := history[len(history)-1]
+	if last.Kind != game.EventState || last.State != game.StateWon || last.Word != "kot" {
+		t.Errorf("ostatnie zdarzenie = %+v, oczekiwano zakończenia gry", last)
+	}
+
+	// Wznowienie od Last-Event-ID zwraca tylko brakujące zdarzenia
+	since := history[1].Seq
+	reader, closeStream = api.openEvents(t, id, since)
+	missed := readAllEvents(t, reader)
+	closeStream()
+
+	if len(missed) != len(history)-since-1 {
+		t.Fatalf("po Last-Event-ID %d odebrano %d zdarzeń, oczekiwano %d", since, len(missed), len(history)-since-1)
+	}
+	for i, event := range missed {
+		if want := history[since+1+i]; event.Seq != want.Seq || event.Kind != want.Kind {
+			t.Errorf("zdarzenie %d = %+v, oczekiwano %+v", i, event, want)
+		}
+	}
+
+	request, _ := http.NewRequest(http.MethodGet, api.http.URL+"/games/"+id+"/events", nil)
+	request.Header.Set("Last-Event-ID", "abc")
+	response, err := api.http.Client().Do(request)
+	if err != nil {
+		t.Fatalf("GET events: %v", err)
+	}
+	response.Body.Close()
+	if response.StatusCode != http.StatusBadRequest {
+		t.Errorf("nieprawidłowy Last-Event-ID: status %d, oczekiwano %d", response.StatusCode, http.StatusBadRequest)
+	}
+}
+
+func TestEventsLive(t *testing.T) {
+	api := startTestAPI(t)
+	id := api.create(t)
+
+	// Obserwator pomija zdarzenie start i czeka na kolejne ruchy
+	reader, closeStream := api.openEvents(t, id, 0)
+	defer closeStream()
+
+	api.guess(t, id, `{"letter": "o"}`, http.StatusOK)
+	event, err := readEvent(reader)
+	if err != nil {
+		t.Fatalf("readEvent: %v", err)
+	}
+	if event.Seq != 1 || event.Kind != game.EventGuess || event.Letter != "o" || event.Pattern != "_ o _" {
+		t.Errorf("zdarzenie = %+v, oczekiwano trafienia litery o", event)
+	}
+	if event.Word != "" {
+		t.Errorf("Word = %q, słowo nie powinno być widoczne w trakcie gry", event.Word)
+	}
+
+	api.guess(t, id, `{"word": "kot"}`, http.StatusOK)
+	rest := readAllEvents(t, reader)
+	if len(rest) == 0 {
+		t.Fatal("strumień zakończył się bez zdarzeń po odgadnięciu słowa")
+	}
+	if last := rest[len(rest)-1]; last.Kind != game.EventState || last.State != game.StateWon {
+		t.Errorf("ostatnie zdarzenie = %+v, oczekiwano wygranej", last)
+	}
+}
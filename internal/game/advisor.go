@@ -0,0 +1,94 @@
+package game
+
+import "sort"
+
+// LetterSuggestion opisuje literę podsuniętą przez doradcę
+type LetterSuggestion struct {
+	Letter rune    // Proponowana litera
+	Count  int     // Liczba kandydatów zawierających literę
+	Share  float64 // Odsetek kandydatów zawierających literę (0-100)
+}
+
+// Advisor podsuwa litery na podstawie słów z paczki, które pasują do stanu gry
+// (odkrytego wzorca, błędnych prób i liczby liter)
+type Advisor struct {
+	words []Word // Słowa paczki, z której wylosowano słowo gry
+}
+
+// NewAdvisor tworzy doradcę dla paczki słów
+func NewAdvisor(words []Word) *Advisor {
+	return &Advisor{words: words}
+}
+
+// Candidates zwraca słowa z paczki zgodne ze wszystkim, co gracz widzi w grze
+func (a *Advisor) Candidates(g *Game) []Word {
+	var candidates []Word
+	for _, word := range a.words {
+		if g.isConsistent(word.Text) {
+			candidates = append(candidates, word)
+		}
+	}
+	return candidates
+}
+
+// Suggest zwraca jeszcze niepodane litery uszeregowane według tego,
+// w ilu kandydatach występują (litery niewystępujące w żadnym kandydacie są pomijane)
+func (a *Advisor) Suggest(g *Game) []LetterSuggestion {
+	candidates := a.Candidates(g)
+	if len(candidates) == 0 {
+		return nil
+	}
+
+	var suggestions []LetterSuggestion
+	for _, letter := range g.Alphabet.Letters {
+		// Bez trybu ścisłego litery ze znakami diakrytycznymi odgaduje się razem z literą podstawową
+		if g.Alphabet.Fold(letter) != string(letter) {
+			continue
+		}
+		if g.isGuessed(letter) || g.isWrongGuess(letter) {
+			continue
+		}
+
+		count := 0
+		for _, candidate := range candidates {
+			if g.containsLetter(candidate.Text, letter) {
+				count++
+			}
+		}
+		if count == 0 {
+			continue
+		}
+
+		suggestions = append(suggestions, LetterSuggestion{
+			Letter: letter,
+			Count:  count,
+			Share:  float64(count) / float64(len(candidates)) * 100,
+		})
+	}
+
+	// Częstsze litery pierwsze; przy remisie kolejność alfabetu
+	sort.SliceStable(suggestions, func(i, j int) bool {
+		return suggestions[i].Count > suggestions[j].Count
+	})
+
+	return suggestions
+}
+
+// BestLetter zwraca literę występującą w największej liczbie kandydatów
+func (a *Advisor) BestLetter(g *Game) (LetterSuggestion, bool) {
+	suggestions := a.Suggest(g)
+	if len(suggestions) == 0 {
+		return LetterSuggestion{}, false
+	}
+	return suggestions[0], true
+}
+
+// containsLetter sprawdza czy litera pasuje do którejś litery słowa
+func (g *Game) containsLetter(word string, letter rune) bool {
+	for _, char := range word {
+		if g.Alphabet.IsLetter(char) && g.Alphabet.Matches(char, letter) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,159 @@
+package game
+
+import (
+	"math"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Alphabet opisuje litery języka i zasady ich upraszczania przy odgadywaniu
+type Alphabet struct {
+	Language string          // Kod języka
+	Letters  string          // Wszystkie dozwolone litery (małe)
+	Folding  map[rune]string // Uproszczenia liter (np. ą→a, ß→ss)
+	Strict   bool            // Tryb ścisły: litery ze znakami diakrytycznymi trzeba odgadywać osobno
+	// Przybliżone częstości liter w języku (w procentach) - wyznaczają rzadkość liter
+	Frequencies map[rune]float64
+}
+
+// Wbudowane alfabety
+var (
+	// Alfabet polski (wraz z q, v i x z zapożyczeń)
+	polishAlphabet = Alphabet{
+		Language: "pl",
+		Letters:  "aąbcćdeęfghijklłmnńoópqrsśtuvwxyzźż",
+		Folding: map[rune]string{
+			'ą': "a", 'ć': "c", 'ę': "e", 'ł': "l", 'ń': "n",
+			'ó': "o", 'ś': "s", 'ź': "z", 'ż': "z",
+		},
+		Frequencies: map[rune]float64{
+			'a': 8.91, 'i': 8.21, 'o': 7.75, 'e': 7.66, 'z': 5.64, 'n': 5.52, 'r': 4.69,
+			'w': 4.65, 's': 4.32, 't': 3.98, 'c': 3.96, 'y': 3.76, 'k': 3.51, 'd': 3.25,
+			'p': 3.13, 'm': 2.80, 'u': 2.50, 'j': 2.28, 'l': 2.10, 'ł': 1.82, 'b': 1.47,
+			'g': 1.42, 'ę': 1.11, 'h': 1.08, 'ą': 0.99, 'ó': 0.85, 'ż': 0.83, 'ś': 0.66,
+			'ć': 0.40, 'f': 0.30, 'ń': 0.20, 'q': 0.14, 'ź': 0.06, 'v': 0.04, 'x': 0.02,
+		},
+	}
+
+	// Alfabet angielski
+	englishAlphabet = Alphabet{
+		Language: "en",
+		Letters:  "abcdefghijklmnopqrstuvwxyz",
+		Frequencies: map[rune]float64{
+			'e': 12.70, 't': 9.06, 'a': 8.17, 'o': 7.51, 'i': 6.97, 'n': 6.75, 's': 6.33,
+			'h': 6.09, 'r': 5.99, 'd': 4.25, 'l': 4.03, 'c': 2.78, 'u': 2.76, 'm': 2.41,
+			'w': 2.36, 'f': 2.23, 'g': 2.02, 'y': 1.97, 'p': 1.93, 'b': 1.29, 'v': 0.98,
+			'k': 0.77, 'j': 0.15, 'x': 0.15, 'q': 0.10, 'z': 0.07,
+		},
+	}
+
+	// Alfabet niemiecki
+	germanAlphabet = Alphabet{
+		Language: "de",
+		Letters:  "abcdefghijklmnopqrstuvwxyzäöüß",
+		Folding: map[rune]string{
+			'ä': "a", 'ö': "o", 'ü': "u", 'ß': "ss",
+		},
+		Frequencies: map[rune]float64{
+			'e': 16.40, 'n': 9.78, 's': 7.27, 'r': 7.00, 'i': 6.55, 'a': 6.52, 't': 6.15,
+			'd': 5.08, 'h': 4.58, 'u': 4.17, 'l': 3.44, 'c': 3.06, 'g': 3.01, 'm': 2.53,
+			'o': 2.51, 'w': 1.92, 'b': 1.89, 'f': 1.66, 'k': 1.42, 'z': 1.13, 'v': 0.85,
+			'p': 0.67, 'ü': 0.65, 'ä': 0.58, 'ö': 0.44, 'ß': 0.31, 'j': 0.27, 'y': 0.04,
+			'x': 0.03, 'q': 0.02,
+		},
+	}
+)
+
+// alphabets zawiera wszystkie wbudowane alfabety
+var alphabets = []Alphabet{polishAlphabet, englishAlphabet, germanAlphabet}
+
+// PolishAlphabet zwraca alfabet polski
+func PolishAlphabet() Alphabet {
+	return polishAlphabet
+}
+
+// GetAlphabet zwraca alfabet dla podanego języka (domyślnie polski)
+func GetAlphabet(language string) Alphabet {
+	for _, alphabet := range alphabets {
+		if alphabet.Language == language {
+			return alphabet
+		}
+	}
+	return polishAlphabet
+}
+
+// CountLetters zwraca liczbę liter alfabetu w słowie (bez spacji i znaków interpunkcyjnych)
+func (a Alphabet) CountLetters(word string) int {
+	count := 0
+	for _, char := range word {
+		if a.IsLetter(char) {
+			count++
+		}
+	}
+	return count
+}
+
+// LetterRarity zwraca rzadkość litery w skali od 0 (najczęstsza) do 1 (najrzadsza lub nieznana)
+// Litera jest najpierw upraszczana, bo np. "a" odkrywa też "ą" (w trybie ścisłym liczy się sama litera)
+func (a Alphabet) LetterRarity(letter rune) float64 {
+	folded, _ := utf8.DecodeRuneInString(a.Fold(letter))
+	frequency, ok := a.Frequencies[folded]
+	if !ok {
+		return 1
+	}
+
+	highest := 0.0
+	for _, other := range a.Frequencies {
+		highest = math.Max(highest, other)
+	}
+	return 1 - frequency/highest
+}
+
+// IsLetter sprawdza czy znak jest literą alfabetu
+func (a Alphabet) IsLetter(r rune) bool {
+	return strings.ContainsRune(a.Letters, unicode.ToLower(r))
+}
+
+// Fold zwraca uproszczoną postać litery (w trybie ścisłym tylko małą literę)
+func (a Alphabet) Fold(r rune) string {
+	lower := unicode.ToLower(r)
+	if !a.Strict {
+		if folded, ok := a.Folding[lower]; ok {
+			return folded
+		}
+	}
+	return string(lower)
+}
+
+// FoldWord zwraca uproszczoną postać słowa lub frazy (z pojedynczymi spacjami między wyrazami)
+func (a Alphabet) FoldWord(word string) string {
+	var result strings.Builder
+	for _, char := range strings.Join(strings.Fields(word), " ") {
+		result.WriteString(a.Fold(char))
+	}
+	return result.String()
+}
+
+// Matches sprawdza czy odgadnięta litera pasuje do litery słowa
+// Litera pasuje także do części uproszczenia (np. s do ß, które upraszcza się do ss)
+func (a Alphabet) Matches(wordChar, guess rune) bool {
+	foldedChar, foldedGuess := a.Fold(wordChar), a.Fold(guess)
+	if foldedChar == foldedGuess {
+		return true
+	}
+	if utf8.RuneCountInString(foldedGuess) == 1 && strings.Contains(foldedChar, foldedGuess) {
+		return true
+	}
+	return utf8.RuneCountInString(foldedChar) == 1 && strings.Contains(foldedGuess, foldedChar)
+}
+
+// HasDiacritics sprawdza czy słowo zawiera litery upraszczane przez alfabet
+func (a Alphabet) HasDiacritics(word string) bool {
+	for _, char := range word {
+		if _, ok := a.Folding[unicode.ToLower(char)]; ok {
+			return true
+		}
+	}
+	return false
+}
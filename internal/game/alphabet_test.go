@@ -0,0 +1,39 @@
+package game
+
+import "testing"
+
+func TestLetterRarityUsesLanguage(t *testing.T) {
+	english, polish := GetAlphabet("en"), GetAlphabet("pl")
+
+	if rarity := english.LetterRarity('e'); rarity != 0 {
+		t.Errorf("en: LetterRarity('e') = %v, oczekiwano 0", rarity)
+	}
+	if rarity := polish.LetterRarity('a'); rarity != 0 {
+		t.Errorf("pl: LetterRarity('a') = %v, oczekiwano 0", rarity)
+	}
+	if english.LetterRarity('w') <= polish.LetterRarity('w') {
+		t.Errorf("litera w powinna być rzadsza w angielskim niż w polskim")
+	}
+
+	// Bez trybu ścisłego "ą" odkrywa się literą "a"
+	if rarity := polish.LetterRarity('ą'); rarity != 0 {
+		t.Errorf("pl: LetterRarity('ą') = %v, oczekiwano 0", rarity)
+	}
+	polish.Strict = true
+	if rarity := polish.LetterRarity('ą'); rarity <= 0.5 {
+		t.Errorf("pl (tryb ścisły): LetterRarity('ą') = %v, oczekiwano rzadkiej litery", rarity)
+	}
+}
+
+func TestWordDifficultyScoreFoldsLetters(t *testing.T) {
+	polish := GetAlphabet("pl")
+	if folded, plain := WordDifficultyScore("mąka", polish), WordDifficultyScore("maka", polish); folded != plain {
+		t.Errorf("WordDifficultyScore(mąka) = %v, WordDifficultyScore(maka) = %v, oczekiwano równych", folded, plain)
+	}
+	if count := GetAlphabet("en").CountLetters("e-mail"); count != 5 {
+		t.Errorf("CountLetters(e-mail) = %d, oczekiwano 5", count)
+	}
+	if count := GetAlphabet("en").CountLetters("żółw"); count != 1 {
+		t.Errorf("en: CountLetters(żółw) = %d, oczekiwano 1 (tylko litery alfabetu)", count)
+	}
+}
@@ -0,0 +1,77 @@
+package game
+
+import "math/rand"
+
+// BenchConfig opisuje serię gier rozgrywanych bez interfejsu przez automatycznego gracza
+type BenchConfig struct {
+	Games      int           // Liczba gier
+	Difficulty Difficulty    // Poziom trudności
+	Strategy   string        // Nazwa strategii automatycznego gracza
+	Scoring    ScoringPolicy // Zasady punktacji
+	Seed       int64         // Ziarno losowania słów i ruchów (ta sama wartość daje te same gry)
+}
+
+// BenchResult zawiera zbiorcze wyniki serii gier
+type BenchResult struct {
+	Difficulty   string // Identyfikator poziomu trudności
+	Strategy     string // Nazwa strategii
+	Games        int    // Liczba rozegranych gier
+	Wins         int    // Liczba wygranych
+	WrongGuesses int    // Łączna liczba wykorzystanych prób
+	Points       int    // Łączna liczba punktów
+}
+
+// WinRate zwraca procent wygranych gier
+func (r BenchResult) WinRate() float64 {
+	if r.Games == 0 {
+		return 0
+	}
+	return float64(r.Wins) / float64(r.Games) * 100
+}
+
+// AverageWrongGuesses zwraca średnią liczbę wykorzystanych prób na grę
+func (r BenchResult) AverageWrongGuesses() float64 {
+	if r.Games == 0 {
+		return 0
+	}
+	return float64(r.WrongGuesses) / float64(r.Games)
+}
+
+// AverageScore zwraca średni wynik punktowy na grę
+func (r BenchResult) AverageScore() float64 {
+	if r.Games == 0 {
+		return 0
+	}
+	return float64(r.Points) / float64(r.Games)
+}
+
+// RunBenchmark rozgrywa serię gier na słowach z managera słów
+// Manager dostaje świeży worek słów i generator z ziarnem konfiguracji,
+// więc każda strategia z tym samym ziarnem gra na tej samej sekwencji słów
+func RunBenchmark(wm *WordsManager, config BenchConfig) (BenchResult, bool) {
+	wm.SetShuffleBag(nil)
+	wm.SetRandSource(rand.NewSource(config.Seed))
+	wm.SetRecentWords(nil)
+
+	strategy, ok := NewSolverStrategy(config.Strategy, wm.GetWords(), rand.NewSource(config.Seed))
+	if !ok {
+		return BenchResult{}, false
+	}
+
+	result := BenchResult{Difficulty: config.Difficulty.ID, Strategy: strategy.Name()}
+	for i := 0; i < config.Games; i++ {
+		g := NewGameFromWord(wm.GetRandomWordForDifficulty(config.Difficulty), config.Difficulty, GameModifiers{}, config.Scoring)
+		g.SetSeed(wm.NewGameSeed())
+
+		Solve(g, strategy)
+
+		result.Games++
+		if g.State == Won {
+			result.Wins++
+		}
+		result.WrongGuesses += g.UsedAttempts()
+		result.Points += g.Points
+	}
+
+	return result, true
+}
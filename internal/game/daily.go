@@ -0,0 +1,63 @@
+package game
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+	"time"
+)
+
+// DailyDateFormat to format daty wyzwania dnia
+const DailyDateFormat = "2006-01-02"
+
+// Symbole ruchów w podsumowaniu wyzwania dnia
+var dailyMoveSymbols = map[string]string{
+	MoveHit:       "🟩",
+	MoveMiss:      "🟥",
+	MoveForgiven:  "🟨",
+	MoveWordHit:   "🎯",
+	MoveWordMiss:  "❌",
+	MoveReveal:    "🔍",
+	MoveHintShown: "💡",
+}
+
+// DailyDate zwraca datę wyzwania dnia dla podanego czasu
+func DailyDate(t time.Time) string {
+	return t.Format(DailyDateFormat)
+}
+
+// DailySeed zwraca ziarno losowania słowa dnia (dla 2024-03-15 jest to 20240315)
+func DailySeed(t time.Time) int64 {
+	return int64(t.Year()*10000 + int(t.Month())*100 + t.Day())
+}
+
+// GetDailyWord zwraca słowo dnia z aktualnej paczki
+// Słowo zależy tylko od daty, paczki i poziomu trudności (nie korzysta z worka słów ani historii gier)
+func (wm *WordsManager) GetDailyWord(date time.Time, difficulty Difficulty) Word {
+	band := difficulty.WordBand()
+	candidates := wm.filterWords(wm.words, func(w Word) bool {
+		return band.Contains(w.Score) && wm.inLengthRange(w.Text, difficulty.MinWordLength, difficulty.MaxWordLength)
+	})
+	if len(candidates) == 0 {
+		candidates = wm.words
+	}
+
+	rng := rand.New(rand.NewSource(DailySeed(date)))
+	return candidates[rng.Intn(len(candidates))]
+}
+
+// DailySummary zwraca podsumowanie wyzwania dnia do udostępnienia (bez ujawniania słowa)
+func DailySummary(g *Game, date string) string {
+	result := "✗"
+	if g.State == Won {
+		result = "✓"
+	}
+
+	var moves strings.Builder
+	for _, move := range g.Moves {
+		moves.WriteString(dailyMoveSymbols[move.Kind])
+	}
+
+	return fmt.Sprintf("Wisielec – wyzwanie dnia %s\n%s %d/%d błędów · %d liter · %d pkt\n%s",
+		date, result, g.UsedAttempts(), g.MaxAttempts, g.Alphabet.CountLetters(g.Word), g.Points, moves.String())
+}
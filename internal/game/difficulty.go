@@ -0,0 +1,171 @@
+package game
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// Identyfikatory wbudowanych poziomów trudności
+const (
+	DifficultyEasy   = "easy"
+	DifficultyMedium = "medium"
+	DifficultyHard   = "hard"
+
+	DefaultDifficulty = DifficultyMedium
+)
+
+// Difficulty opisuje poziom trudności gry
+type Difficulty struct {
+	ID              string   `json:"id"`                      // Stały identyfikator (zapisywany w statystykach)
+	Name            string   `json:"name"`                    // Nazwa wyświetlana w menu
+	Attempts        int      `json:"attempts"`                // Liczba prób
+	MinWordLength   int      `json:"min_word_length"`         // Minimalna liczba liter w słowie (0 = bez ograniczenia)
+	MaxWordLength   int      `json:"max_word_length"`         // Maksymalna liczba liter w słowie (0 = bez ograniczenia)
+	MinWordScore    float64  `json:"min_word_score"`          // Minimalna trudność słowa (0-100)
+	MaxWordScore    float64  `json:"max_word_score"`          // Maksymalna trudność słowa (0 = bez ograniczenia)
+	ScoreMultiplier float64  `json:"score_multiplier"`        // Mnożnik punktów
+	AllowedItems    []string `json:"allowed_items,omitempty"` // Przedmioty dozwolone w grze (puste = wszystkie)
+}
+
+// WordBand zwraca zakres trudności słów dla poziomu trudności
+func (d Difficulty) WordBand() WordBand {
+	return WordBand{Min: d.MinWordScore, Max: d.MaxWordScore}
+}
+
+// AllowsItem sprawdza czy przedmiot może być użyty na tym poziomie trudności
+func (d Difficulty) AllowsItem(itemID string) bool {
+	if len(d.AllowedItems) == 0 {
+		return true
+	}
+
+	for _, allowed := range d.AllowedItems {
+		if allowed == itemID {
+			return true
+		}
+	}
+	return false
+}
+
+// BuiltinDifficulties zwraca wbudowane poziomy trudności
+func BuiltinDifficulties() []Difficulty {
+	return []Difficulty{
+		{
+			ID:              DifficultyEasy,
+			Name:            "Łatwy",
+			Attempts:        EasyLevel,
+			MaxWordLength:   8,
+			MaxWordScore:    47,
+			ScoreMultiplier: 0.8,
+		},
+		{
+			ID:              DifficultyMedium,
+			Name:            "Średni",
+			Attempts:        MediumLevel,
+			MinWordLength:   5,
+			MaxWordLength:   12,
+			MinWordScore:    42,
+			MaxWordScore:    60,
+			ScoreMultiplier: 1.0,
+		},
+		{
+			ID:              DifficultyHard,
+			Name:            "Trudny",
+			Attempts:        HardLevel,
+			MinWordLength:   7,
+			MinWordScore:    55,
+			ScoreMultiplier: 1.5,
+			AllowedItems:    []string{"potion_hint"},
+		},
+	}
+}
+
+// DifficultyManager zarządza poziomami trudności
+type DifficultyManager struct {
+	difficulties []Difficulty
+}
+
+// NewDifficultyManager tworzy nowy manager poziomów trudności
+// Plik konfiguracyjny (jeśli istnieje) może nadpisać wbudowane poziomy i dodać własne
+func NewDifficultyManager(filePath string) (*DifficultyManager, error) {
+	dm := &DifficultyManager{difficulties: BuiltinDifficulties()}
+
+	data, err := os.ReadFile(filePath)
+	if os.IsNotExist(err) {
+		return dm, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var custom []Difficulty
+	if err := json.Unmarshal(data, &custom); err != nil {
+		return nil, err
+	}
+
+	for _, difficulty := range custom {
+		if err := dm.Add(difficulty); err != nil {
+			return nil, err
+		}
+	}
+
+	return dm, nil
+}
+
+// Add dodaje poziom trudności lub nadpisuje istniejący o tym samym identyfikatorze
+func (dm *DifficultyManager) Add(difficulty Difficulty) error {
+	if difficulty.ID == "" {
+		return fmt.Errorf("poziom trudności bez identyfikatora")
+	}
+	if difficulty.Attempts < 1 {
+		return fmt.Errorf("poziom trudności %q: liczba prób musi być większa od zera", difficulty.ID)
+	}
+	if difficulty.MaxWordLength > 0 && difficulty.MinWordLength > difficulty.MaxWordLength {
+		return fmt.Errorf("poziom trudności %q: minimalna długość słowa większa od maksymalnej", difficulty.ID)
+	}
+	if difficulty.MaxWordScore > 0 && difficulty.MinWordScore > difficulty.MaxWordScore {
+		return fmt.Errorf("poziom trudności %q: minimalna trudność słowa większa od maksymalnej", difficulty.ID)
+	}
+	if difficulty.Name == "" {
+		difficulty.Name = difficulty.ID
+	}
+	if difficulty.ScoreMultiplier <= 0 {
+		difficulty.ScoreMultiplier = 1
+	}
+
+	for i, existing := range dm.difficulties {
+		if existing.ID == difficulty.ID {
+			dm.difficulties[i] = difficulty
+			return nil
+		}
+	}
+
+	dm.difficulties = append(dm.difficulties, difficulty)
+	return nil
+}
+
+// GetAll zwraca wszystkie poziomy trudności
+func (dm *DifficultyManager) GetAll() []Difficulty {
+	return dm.difficulties
+}
+
+// Get zwraca poziom trudności o podanym identyfikatorze
+func (dm *DifficultyManager) Get(id string) (Difficulty, bool) {
+	for _, difficulty := range dm.difficulties {
+		if difficulty.ID == id {
+			return difficulty, true
+		}
+	}
+	return Difficulty{}, false
+}
+
+// GetOrDefault zwraca poziom trudności o podanym identyfikatorze lub poziom domyślny
+func (dm *DifficultyManager) GetOrDefault(id string) Difficulty {
+	if difficulty, ok := dm.Get(id); ok {
+		return difficulty
+	}
+	if difficulty, ok := dm.Get(DefaultDifficulty); ok {
+		return difficulty
+	}
+	return dm.difficulties[0]
+}
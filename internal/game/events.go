@@ -0,0 +1,121 @@
+package game
+
+import "time"
+
+// Rodzaje zdarzeń emitowanych przez grę
+const (
+	EventStart    = "start"    // Początek gry (stan wyjściowy)
+	EventGuess    = "guess"    // Wynik ruchu (litera, słowo, odkrycie litery lub podpowiedzi)
+	EventScore    = "score"    // Zmiana liczby punktów
+	EventState    = "state"    // Zmiana stanu gry (wygrana lub przegrana)
+	EventAttempts = "attempts" // Zmiana liczby pozostałych prób poza ruchem (np. dodatkowe życie)
+)
+
+// GameEvent opisuje zmianę w grze widoczną dla graczy i obserwatorów
+type GameEvent struct {
+	Seq               int       `json:"seq"`                    // Numer kolejny zdarzenia w grze (od 1; StartEvent ma numer 0)
+	Kind              string    `json:"kind"`                   // Rodzaj zdarzenia (Event...)
+	Move              string    `json:"move,omitempty"`         // Rodzaj ruchu (Move...) dla EventGuess
+	Letter            string    `json:"letter,omitempty"`       // Litera, której dotyczy ruch
+	Guess             string    `json:"guess,omitempty"`        // Słowo podane przez gracza
+	Pattern           string    `json:"pattern"`                // Słowo z odkrytymi literami po zdarzeniu
+	Points            int       `json:"points"`                 // Punkty po zdarzeniu
+	PointsDelta       int       `json:"points_delta,omitempty"` // Zmiana punktów (dla EventScore)
+	RemainingAttempts int       `json:"remaining_attempts"`     // Pozostałe próby po zdarzeniu
+	State             string    `json:"state"`                  // Stan gry po zdarzeniu (State...)
+	Word              string    `json:"word,omitempty"`         // Słowo (tylko po zakończeniu gry)
+	Time              time.Time `json:"time"`
+}
+
+// EventListener odbiera zdarzenia gry; jest wywoływany synchronicznie po każdym ruchu
+type EventListener func(event GameEvent)
+
+// eventLog śledzi, które zmiany w grze zostały już zgłoszone słuchaczom
+type eventLog struct {
+	listeners []EventListener
+	seq       int       // Numer następnego zdarzenia
+	moves     int       // Liczba zgłoszonych ruchów
+	points    int       // Ostatnio zgłoszone punkty
+	remaining int       // Ostatnio zgłoszona liczba pozostałych prób
+	state     GameState // Ostatnio zgłoszony stan gry
+}
+
+// AddListener dodaje słuchacza zdarzeń gry; zgłaszane są tylko zmiany po jego dodaniu
+func (g *Game) AddListener(listener EventListener) {
+	if len(g.events.listeners) == 0 {
+		g.events.moves = len(g.Moves)
+		g.events.points = g.Points
+		g.events.remaining = g.GetRemainingAttempts()
+		g.events.state = g.State
+	}
+	g.events.listeners = append(g.events.listeners, listener)
+}
+
+// StartEvent zwraca zdarzenie opisujące aktualny stan gry (np. dla obserwatora, który dołącza później)
+func (g *Game) StartEvent() GameEvent {
+	return g.newEvent(EventStart)
+}
+
+// newEvent tworzy zdarzenie z aktualnym stanem gry
+func (g *Game) newEvent(kind string) GameEvent {
+	event := GameEvent{
+		Kind:              kind,
+		Pattern:           g.GetWordWithGuesses(),
+		Points:            g.Points,
+		RemainingAttempts: g.GetRemainingAttempts(),
+		State:             g.State.String(),
+		Time:              time.Now(),
+	}
+	if g.State != Playing {
+		event.Word = g.Word
+	}
+	return event
+}
+
+// emitEvents zgłasza słuchaczom ruchy, zmiany punktów i stanu gry od ostatniego zgłoszenia
+func (g *Game) emitEvents() {
+	if len(g.events.listeners) == 0 {
+		return
+	}
+
+	for _, move := range g.Moves[g.events.moves:] {
+		event := g.newEvent(EventGuess)
+		event.Move = move.Kind
+		event.Guess = move.Word
+		if move.Letter != 0 {
+			event.Letter = string(move.Letter)
+		}
+		g.emit(event)
+	}
+	if len(g.Moves) > g.events.moves {
+		// Zdarzenia ruchów zawierają już liczbę pozostałych prób
+		g.events.remaining = g.GetRemainingAttempts()
+	}
+	g.events.moves = len(g.Moves)
+
+	if remaining := g.GetRemainingAttempts(); remaining != g.events.remaining {
+		g.events.remaining = remaining
+		g.emit(g.newEvent(EventAttempts))
+	}
+
+	if g.Points != g.events.points {
+		event := g.newEvent(EventScore)
+		event.PointsDelta = g.Points - g.events.points
+		g.events.points = g.Points
+		g.emit(event)
+	}
+
+	if g.State != g.events.state {
+		g.events.state = g.State
+		g.emit(g.newEvent(EventState))
+	}
+}
+
+// emit nadaje zdarzeniu numer i przekazuje je wszystkim słuchaczom
+func (g *Game) emit(event GameEvent) {
+	g.events.seq++
+	event.Seq = g.events.seq
+	for _, listener := range g.events.listeners {
+		listener(event)
+	}
+}
@@ -0,0 +1,86 @@
+package game
+
+import "testing"
+
+// recordEvents zapisuje wszystkie zdarzenia zgłoszone przez grę
+func recordEvents(g *Game) *[]GameEvent {
+	events := &[]GameEvent{}
+	g.AddListener(func(event GameEvent) {
+		*events = append(*events, event)
+	})
+	return events
+}
+
+func TestIntelligenceRevealFollowsGuess(t *testing.T) {
+	g := NewGame("kotek", Difficulty{ID: "medium", Attempts: 6}, GameModifiers{HintChance: 1}, nil)
+	g.SetSeed(1)
+	events := recordEvents(g)
+
+	if !g.Guess('k') {
+		t.Fatal("Guess('k') = false")
+	}
+
+	var kinds []string
+	for i, event := range *events {
+		if event.Seq != i+1 {
+			t.Errorf("zdarzenie %d ma numer %d, oczekiwano %d", i, event.Seq, i+1)
+		}
+		kinds = append(kinds, event.Kind+":"+event.Move)
+	}
+	want := []string{EventGuess + ":" + MoveHit, EventScore + ":", EventGuess + ":" + MoveReveal}
+	if len(kinds) < len(want) {
+		t.Fatalf("zdarzenia = %v, oczekiwano %v", kinds, want)
+	}
+	for i := range want {
+		if kinds[i] != want[i] {
+			t.Fatalf("zdarzenia = %v, oczekiwano %v", kinds, want)
+		}
+	}
+
+	hit, score, reveal := (*events)[0], (*events)[1], (*events)[2]
+	if hit.Pattern != "k _ _ _ k" {
+		t.Errorf("wzorzec po trafieniu = %q, oczekiwano %q", hit.Pattern, "k _ _ _ k")
+	}
+	if score.Points != hit.Points || score.PointsDelta != hit.Points {
+		t.Errorf("zdarzenie punktów = %+v, oczekiwano punktów tylko za trafienie (%d)", score, hit.Points)
+	}
+	if reveal.Pattern == hit.Pattern || reveal.Letter == "" {
+		t.Errorf("odkrycie litery = %+v, oczekiwano nowej litery we wzorcu", reveal)
+	}
+	if last := (*events)[len(*events)-1]; last.Pattern != g.GetWordWithGuesses() {
+		t.Errorf("ostatni wzorzec = %q, oczekiwano %q", last.Pattern, g.GetWordWithGuesses())
+	}
+}
+
+func TestAddAttemptsEmitsEvent(t *testing.T) {
+	g := NewGame("kot", Difficulty{ID: "medium", Attempts: 6}, GameModifiers{}, nil)
+	events := recordEvents(g)
+
+	results := ApplyItemEffects(g, []RPGItemEffect{{Type: "extra_life", Value: 2}})
+	if len(results) != 1 || !results[0].Applied {
+		t.Fatalf("ApplyItemEffects = %+v, oczekiwano dodatkowego życia", results)
+	}
+	if len(*events) != 1 || (*events)[0].Kind != EventAttempts || (*events)[0].RemainingAttempts != 8 {
+		t.Fatalf("zdarzenia = %+v, oczekiwano jednego zdarzenia %s z 8 próbami", *events, EventAttempts)
+	}
+
+	// Błędna litera zmienia liczbę prób w zdarzeniu ruchu, bez osobnego zdarzenia prób
+	*events = nil
+	g.Guess('x')
+	for _, event := range *events {
+		if event.Kind == EventAttempts {
+			t.Errorf("zbędne zdarzenie %s po błędnej literze: %+v", EventAttempts, *events)
+		}
+	}
+}
+
+func TestForfeitEmitsState(t *testing.T) {
+	template := NewGame("kot", Difficulty{ID: "medium", Attempts: 6}, GameModifiers{}, nil)
+	race := NewRace(template, []string{"ala", "ola"})
+	events := recordEvents(race.Players[1].Game)
+
+	race.Forfeit(1)
+	if len(*events) != 1 || (*events)[0].Kind != EventState || (*events)[0].State != StateLost {
+		t.Fatalf("zdarzenia = %+v, oczekiwano przegranej", *events)
+	}
+}
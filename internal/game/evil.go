@@ -0,0 +1,218 @@
+package game
+
+import "strings"
+
+// ModeEvil to nazwa trybu "złośliwego wisielca" (zapisywana w statystykach)
+const ModeEvil = "evil"
+
+// Adversary może podmienić słowo gry w trakcie rozgrywki (np. w trybie złośliwego wisielca)
+type Adversary interface {
+	// BeforeGuess jest wywoływane przed sprawdzeniem poprawnej, nowej litery
+	BeforeGuess(g *Game, letter rune)
+	// BeforeWordGuess jest wywoływane przed sprawdzeniem podanego całego słowa
+	BeforeWordGuess(g *Game, word string)
+	// AfterMove jest wywoływane po każdym ruchu, który zmienił stan gry
+	AfterMove(g *Game)
+}
+
+// EvilEngine to silnik trybu złośliwego wisielca: komputer nie wybiera słowa na stałe,
+// tylko po każdej próbie zostawia największą rodzinę słów zgodnych z pokazanym wzorcem
+type EvilEngine struct {
+	candidates []Word // Słowa zgodne ze wszystkim, co gracz dotąd zobaczył
+}
+
+// NewEvilGame tworzy grę w trybie złośliwego wisielca
+// Kandydatami są słowa z paczki o tym samym kształcie (liczba liter, spacje i znaki interpunkcyjne) co słowo startowe
+func NewEvilGame(word Word, pack []Word, difficulty Difficulty, modifiers GameModifiers, scoring ScoringPolicy) *Game {
+	g := NewGameFromWord(word, difficulty, modifiers, scoring)
+	g.Mode = ModeEvil
+
+	engine := &EvilEngine{}
+	for _, candidate := range pack {
+		if candidate.Language == word.Language && sameShape(g.Alphabet, strings.ToLower(candidate.Text), g.Word) {
+			engine.candidates = append(engine.candidates, candidate)
+		}
+	}
+	if len(engine.candidates) == 0 {
+		engine.candidates = []Word{word}
+	}
+
+	g.adversary = engine
+	engine.updateWordInfo(g)
+	return g
+}
+
+// CandidateCount zwraca liczbę słów, które nadal pasują do stanu gry
+func (e *EvilEngine) CandidateCount() int {
+	return len(e.candidates)
+}
+
+// BeforeGuess wybiera największą rodzinę kandydatów dla podanej litery
+// i podmienia słowo gry na słowo z tej rodziny
+func (e *EvilEngine) BeforeGuess(g *Game, letter rune) {
+	families := make(map[string][]Word)
+	var patterns []string
+	for _, candidate := range e.candidates {
+		pattern := revealPattern(g.Alphabet, candidate.Text, letter)
+		if _, ok := families[pattern]; !ok {
+			patterns = append(patterns, pattern)
+		}
+		families[pattern] = append(families[pattern], candidate)
+	}
+
+	// Największa rodzina wygrywa; przy remisie ta, która odkrywa mniej liter
+	best := ""
+	for i, pattern := range patterns {
+		if i == 0 || len(families[pattern]) > len(families[best]) ||
+			(len(families[pattern]) == len(families[best]) && revealedCount(pattern) < revealedCount(best)) {
+			best = pattern
+		}
+	}
+
+	e.candidates = families[best]
+	e.commit(g)
+}
+
+// BeforeWordGuess usuwa podane słowo z kandydatów, o ile zostają jeszcze inne słowa
+func (e *EvilEngine) BeforeWordGuess(g *Game, word string) {
+	guessed := g.Alphabet.FoldWord(word)
+
+	var remaining []Word
+	for _, candidate := range e.candidates {
+		if g.Alphabet.FoldWord(candidate.Text) != guessed {
+			remaining = append(remaining, candidate)
+		}
+	}
+	if len(remaining) == 0 {
+		return
+	}
+
+	e.candidates = remaining
+	e.commit(g)
+}
+
+// AfterMove odrzuca kandydatów niezgodnych z aktualnym stanem gry
+// (np. po literze odkrytej przez przedmiot lub Inteligencję)
+func (e *EvilEngine) AfterMove(g *Game) {
+	var remaining []Word
+	for _, candidate := range e.candidates {
+		if g.isConsistent(candidate.Text) {
+			remaining = append(remaining, candidate)
+		}
+	}
+	if len(remaining) == 0 {
+		return
+	}
+
+	e.candidates = remaining
+	e.commit(g)
+}
+
+// commit ustawia słowo gry na kandydata (zostawia obecne słowo, jeśli wciąż jest kandydatem)
+func (e *EvilEngine) commit(g *Game) {
+	for _, candidate := range e.candidates {
+		if strings.ToLower(candidate.Text) == g.Word {
+			e.updateWordInfo(g)
+			return
+		}
+	}
+
+	g.Word = strings.ToLower(e.candidates[0].Text)
+	e.updateWordInfo(g)
+}
+
+// updateWordInfo ustawia kategorię i podpowiedź tak, aby nie zdradzały słowa:
+// kategoria jest widoczna, gdy wszyscy kandydaci ją dzielą, a podpowiedź dopiero przy ostatnim kandydacie
+func (e *EvilEngine) updateWordInfo(g *Game) {
+	g.Category = e.candidates[0].Category
+	for _, candidate := range e.candidates {
+		if candidate.Category != g.Category {
+			g.Category = ""
+			break
+		}
+	}
+
+	if len(e.candidates) == 1 {
+		g.Hint = e.candidates[0].Hint
+	} else if !g.HintRevealed {
+		g.Hint = ""
+	}
+}
+
+// sameShape sprawdza czy słowa mają litery i pozostałe znaki na tych samych pozycjach
+func sameShape(alphabet Alphabet, a, b string) bool {
+	runesA, runesB := []rune(a), []rune(b)
+	if len(runesA) != len(runesB) {
+		return false
+	}
+
+	for i := range runesA {
+		letterA, letterB := alphabet.IsLetter(runesA[i]), alphabet.IsLetter(runesB[i])
+		if letterA != letterB || (!letterA && runesA[i] != runesB[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// revealPattern zwraca wzorzec liter słowa, które odkryłaby podana litera ("_" w pozostałych miejscach)
+func revealPattern(alphabet Alphabet, word string, letter rune) string {
+	var pattern strings.Builder
+	for _, char := range strings.ToLower(word) {
+		if alphabet.IsLetter(char) && alphabet.Matches(char, letter) {
+			pattern.WriteRune(char)
+		} else {
+			pattern.WriteRune('_')
+		}
+	}
+	return pattern.String()
+}
+
+// revealedCount zwraca liczbę odkrytych znaków we wzorcu
+func revealedCount(pattern string) int {
+	return len([]rune(pattern)) - strings.Count(pattern, "_")
+}
+
+// isConsistent sprawdza czy słowo pasuje do wszystkiego, co gracz widzi:
+// odkrytych liter, błędnych prób i błędnie podanych słów
+func (g *Game) isConsistent(word string) bool {
+	word = strings.ToLower(word)
+	if !sameShape(g.Alphabet, word, g.Word) {
+		return false
+	}
+
+	wordRunes := []rune(word)
+	for i, char := range []rune(g.Word) {
+		candidate := wordRunes[i]
+		if g.isRevealed(char) {
+			if candidate != char {
+				return false
+			}
+		} else if g.isGuessed(candidate) {
+			return false
+		}
+
+		if g.Alphabet.IsLetter(candidate) && g.isMissed(candidate) {
+			return false
+		}
+	}
+
+	folded := g.Alphabet.FoldWord(word)
+	for _, wrong := range g.WrongWords {
+		if g.Alphabet.FoldWord(wrong) == folded {
+			return false
+		}
+	}
+
+	return true
+}
+
+// isMissed sprawdza czy litera słowa pasuje do którejś z błędnych (również wybaczonych) prób
+func (g *Game) isMissed(char rune) bool {
+	for _, wrong := range append(append([]rune{}, g.WrongGuesses...), g.ForgivenGuesses...) {
+		if g.Alphabet.Matches(char, wrong) {
+			return true
+		}
+	}
+	return false
+}
@@ -1,16 +1,26 @@
 package game
 
 import (
+	"math"
+	"math/rand"
 	"strings"
+	"time"
 )
 
-// Poziomy trudności
+// Liczba prób na wbudowanych poziomach trudności
 const (
 	EasyLevel   = 8 // 8 prób
 	MediumLevel = 6 // 6 prób
 	HardLevel   = 4 // 4 próby
 )
 
+// Domyślne zasady odgadywania całego słowa
+const (
+	DefaultWordGuessBonus   = 20 // Bonus punktowy za odgadnięcie całego słowa
+	DefaultWordGuessPenalty = 2  // Liczba prób traconych za błędne słowo
+	HintCost                = 15 // Koszt odkrycia podpowiedzi w punktach
+)
+
 // GameState reprezentuje stan gry
 type GameState int
 
@@ -21,45 +31,151 @@ const (
 	Lost
 )
 
+// Atrybuty, które mogą zadziałać w trakcie gry
+const (
+	TriggerResilience   = "resilience"   // Dodatkowe próby na start
+	TriggerPerception   = "perception"   // Dodatkowe punkty za trafienie
+	TriggerLuck         = "luck"         // Wybaczony błąd
+	TriggerIntelligence = "intelligence" // Darmowa podpowiedź
+	TriggerAdvice       = "advice"       // Litera podsunięta przez doradcę (Inteligencja)
+)
+
+// Rodzaje ruchów zapisywanych w przebiegu gry
+const (
+	MoveHit       = "hit"       // Trafiona litera
+	MoveMiss      = "miss"      // Błędna litera
+	MoveForgiven  = "forgiven"  // Błędna litera wybaczona dzięki szczęściu
+	MoveWordHit   = "word_hit"  // Odgadnięte całe słowo
+	MoveWordMiss  = "word_miss" // Błędnie podane całe słowo
+	MoveReveal    = "reveal"    // Litera odkryta przez podpowiedź lub przedmiot
+	MoveHintShown = "hint"      // Odkryta podpowiedź do słowa
+)
+
+// Move opisuje pojedynczy ruch w grze
+type Move struct {
+	Kind   string // Rodzaj ruchu (Move...)
+	Letter rune   // Litera, której dotyczy ruch
+	Word   string // Słowo podane przez gracza
+}
+
+// GameModifiers reprezentuje modyfikatory rozgrywki wynikające z atrybutów RPG
+type GameModifiers struct {
+	ExtraAttempts int     `json:"extra_attempts,omitempty"` // Dodatkowe próby (Odporność)
+	PointsPerHit  int     `json:"points_per_hit,omitempty"` // Dodatkowe punkty za trafienie (Percepcja)
+	ForgiveChance float64 `json:"forgive_chance,omitempty"` // Szansa na wybaczenie błędu (Szczęście)
+	HintChance    float64 `json:"hint_chance,omitempty"`    // Szansa na darmową podpowiedź (Inteligencja)
+}
+
+// AttributeTrigger opisuje zadziałanie atrybutu w trakcie gry
+type AttributeTrigger struct {
+	Attribute string `json:"attribute"`        // Atrybut, który zadziałał (Trigger...)
+	Value     int    `json:"value,omitempty"`  // Wartość bonusu (próby lub punkty)
+	Letter    rune   `json:"letter,omitempty"` // Litera, której dotyczy bonus (wybaczona lub odkryta)
+}
+
 // Game reprezentuje pojedynczą rozgrywkę
 type Game struct {
-	Word           string    // Słowo do odgadnięcia
-	GuessedLetters []rune    // Odgadnięte litery
-	WrongGuesses   []rune    // Błędne próby
-	MaxAttempts    int       // Maksymalna liczba prób
-	Points         int       // Punkty zdobyte w grze
-	State          GameState // Aktualny stan gry
+	Word             string             // Słowo do odgadnięcia
+	StartWord        string             // Słowo z początku gry (w trybie złośliwego wisielca słowo zmienia się w trakcie)
+	Category         string             // Kategoria słowa
+	Hint             string             // Podpowiedź do słowa
+	HintRevealed     bool               // Czy podpowiedź została odkryta
+	Language         string             // Kod języka słowa
+	Alphabet         Alphabet           // Alfabet języka słowa (litery i zasady upraszczania)
+	GuessedLetters   []rune             // Odgadnięte litery
+	WrongGuesses     []rune             // Błędne próby
+	ForgivenGuesses  []rune             // Błędne próby wybaczone dzięki szczęściu
+	WrongWords       []string           // Błędnie podane całe słowa
+	PenaltyAttempts  int                // Próby stracone za błędne słowa
+	WordGuessBonus   int                // Bonus za odgadnięcie całego słowa
+	WordGuessPenalty int                // Liczba prób traconych za błędne słowo
+	MaxAttempts      int                // Maksymalna liczba prób
+	Points           int                // Punkty zdobyte w grze
+	State            GameState          // Aktualny stan gry
+	Difficulty       Difficulty         // Poziom trudności
+	Modifiers        GameModifiers      // Modyfikatory z atrybutów RPG
+	Scoring          ScoringPolicy      // Zasady punktacji
+	Streak           int                // Seria kolejnych trafionych liter
+	Triggers         []AttributeTrigger // Atrybuty, które zadziałały w ostatnim ruchu
+	Moves            []Move             // Przebieg gry (kolejne ruchy)
+	Seed             int64              // Ziarno losowości gry (efekty szczęścia, inteligencji i użytych przedmiotów, zob. NewSeededGame)
+	Mode             string             // Tryb gry (pusty dla zwykłej gry, np. ModeEvil)
+	Advisor          *Advisor           // Doradca liter (nil = niedostępny)
+	AdvisorHints     bool               // Czy Inteligencja podsuwa literę od doradcy zamiast ją odkrywać
+	adversary        Adversary          // Przeciwnik, który może podmieniać słowo (nil w zwykłej grze)
+	events           eventLog           // Słuchacze zdarzeń gry
+	rng              *rand.Rand
 }
 
 // NewGame tworzy nową grę
-func NewGame(word string, difficultyLevel int) *Game {
-	maxAttempts := MediumLevel // Domyślnie średni poziom trudności
+func NewGame(word string, difficulty Difficulty, modifiers GameModifiers, scoring ScoringPolicy) *Game {
+	if difficulty.Attempts < 1 {
+		difficulty.Attempts = MediumLevel
+	}
+	if difficulty.ScoreMultiplier <= 0 {
+		difficulty.ScoreMultiplier = 1
+	}
+
+	if scoring == nil {
+		scoring = ClassicScoring{}
+	}
 
-	// Ustawienie poziomu trudności
-	switch difficultyLevel {
-	case 1:
-		maxAttempts = EasyLevel
-	case 2:
-		maxAttempts = MediumLevel
-	case 3:
-		maxAttempts = HardLevel
+	g := &Game{
+		Word:             strings.ToLower(word),
+		StartWord:        strings.ToLower(word),
+		GuessedLetters:   []rune{},
+		WrongGuesses:     []rune{},
+		ForgivenGuesses:  []rune{},
+		WrongWords:       []string{},
+		WordGuessBonus:   DefaultWordGuessBonus,
+		WordGuessPenalty: DefaultWordGuessPenalty,
+		MaxAttempts:      difficulty.Attempts,
+		Points:           0,
+		State:            Playing,
+		Difficulty:       difficulty,
+		Modifiers:        modifiers,
+		Scoring:          scoring,
+		Alphabet:         PolishAlphabet(),
 	}
+	g.SetSeed(time.Now().UnixNano())
 
-	return &Game{
-		Word:           strings.ToLower(word),
-		GuessedLetters: []rune{},
-		WrongGuesses:   []rune{},
-		MaxAttempts:    maxAttempts,
-		Points:         0,
-		State:          Playing,
+	// Odporność dodaje próby już na starcie
+	if modifiers.ExtraAttempts > 0 {
+		g.MaxAttempts += modifiers.ExtraAttempts
+		g.Triggers = append(g.Triggers, AttributeTrigger{Attribute: TriggerResilience, Value: modifiers.ExtraAttempts})
 	}
+
+	return g
+}
+
+// NewGameFromWord tworzy nową grę dla słowa z bazy (wraz z kategorią i podpowiedzią)
+func NewGameFromWord(word Word, difficulty Difficulty, modifiers GameModifiers, scoring ScoringPolicy) *Game {
+	g := NewGame(word.Text, difficulty, modifiers, scoring)
+	g.Category = word.Category
+	g.Hint = word.Hint
+	g.Language = word.Language
+	g.Alphabet = GetAlphabet(word.Language)
+	return g
+}
+
+// SetSeed ustawia ziarno losowości gry; to samo ziarno przy tych samych ruchach
+// daje te same efekty atrybutów i przedmiotów
+func (g *Game) SetSeed(seed int64) {
+	g.Seed = seed
+	g.rng = rand.New(rand.NewSource(seed))
 }
 
 // GetWordWithGuesses zwraca słowo z widocznymi odgadniętymi literami
 func (g *Game) GetWordWithGuesses() string {
 	result := ""
 	for _, char := range g.Word {
-		if g.isGuessed(char) {
+		if char == ' ' {
+			// Odstęp między wyrazami frazy jest szerszy niż między literami
+			result += "  "
+			continue
+		}
+
+		if g.isRevealed(char) {
 			result += string(char)
 		} else {
 			result += "_"
@@ -69,11 +185,16 @@ func (g *Game) GetWordWithGuesses() string {
 	return strings.TrimSpace(result)
 }
 
+// isRevealed sprawdza czy znak słowa jest widoczny dla gracza
+// (znaki niebędące literami, np. spacje, myślniki i apostrofy, są widoczne od początku)
+func (g *Game) isRevealed(char rune) bool {
+	return !g.Alphabet.IsLetter(char) || g.isGuessed(char)
+}
+
 // isGuessed sprawdza czy litera została już odgadnięta
 func (g *Game) isGuessed(letter rune) bool {
-	normalizedLetter := NormalizeGuess(letter)
 	for _, guessed := range g.GuessedLetters {
-		if NormalizeGuess(guessed) == normalizedLetter {
+		if g.Alphabet.Matches(letter, guessed) {
 			return true
 		}
 	}
@@ -81,10 +202,15 @@ func (g *Game) isGuessed(letter rune) bool {
 }
 
 // isWrongGuess sprawdza czy litera znajduje się w liście błędnych prób
+// (porównanie tak jak w isGuessed, np. po błędnym "s" także "ß" jest powtórzeniem)
 func (g *Game) isWrongGuess(letter rune) bool {
-	normalizedLetter := NormalizeGuess(letter)
 	for _, wrong := range g.WrongGuesses {
-		if NormalizeGuess(wrong) == normalizedLetter {
+		if g.Alphabet.Matches(letter, wrong) {
+			return true
+		}
+	}
+	for _, forgiven := range g.ForgivenGuesses {
+		if g.Alphabet.Matches(letter, forgiven) {
 			return true
 		}
 	}
@@ -98,69 +224,248 @@ func (g *Game) Guess(letter rune) bool {
 		return false
 	}
 
+	// Znaki spoza alfabetu języka słowa nie są literami
+	if !g.Alphabet.IsLetter(letter) {
+		return false
+	}
+
 	// Jeśli litera została już odgadnięta lub jest błędną próbą, zwróć false
-	normalizedLetter := NormalizeGuess(letter)
-	if g.isGuessed(normalizedLetter) || g.isWrongGuess(normalizedLetter) {
+	if g.isGuessed(letter) || g.isWrongGuess(letter) {
 		return false
 	}
 
+	if g.adversary != nil {
+		g.adversary.BeforeGuess(g, letter)
+	}
+
 	// Sprawdź czy litera znajduje się w słowie
 	letterInWord := false
 	for _, char := range g.Word {
-		if NormalizeGuess(char) == normalizedLetter {
+		if g.Alphabet.IsLetter(char) && g.Alphabet.Matches(char, letter) {
 			letterInWord = true
 			break
 		}
 	}
 
+	g.Triggers = nil
+
 	if letterInWord {
 		g.GuessedLetters = append(g.GuessedLetters, letter)
+		g.Moves = append(g.Moves, Move{Kind: MoveHit, Letter: letter})
 
-		// Dodaj punkty za odgadniętą literę
-		g.Points += 10
+		// Dodaj punkty za odgadniętą literę (z bonusem percepcji)
+		g.Streak++
+		g.addPoints(g.Scoring.LetterHit(g, letter))
+		if g.Modifiers.PointsPerHit > 0 {
+			g.addPoints(g.Modifiers.PointsPerHit)
+			g.Triggers = append(g.Triggers, AttributeTrigger{Attribute: TriggerPerception, Value: g.Modifiers.PointsPerHit, Letter: letter})
+		}
 
 		// Sprawdź czy wszystkie litery zostały odgadnięte
-		allGuessed := true
-		for _, char := range g.Word {
-			if !g.isGuessed(char) {
-				allGuessed = false
-				break
+		g.checkWin()
+	} else if g.rng.Float64() < g.Modifiers.ForgiveChance {
+		// Szczęście wybacza błąd - litera nie kosztuje próby
+		g.ForgivenGuesses = append(g.ForgivenGuesses, letter)
+		g.Moves = append(g.Moves, Move{Kind: MoveForgiven, Letter: letter})
+		g.Triggers = append(g.Triggers, AttributeTrigger{Attribute: TriggerLuck, Letter: letter})
+	} else {
+		g.WrongGuesses = append(g.WrongGuesses, letter)
+		g.Moves = append(g.Moves, Move{Kind: MoveMiss, Letter: letter})
+		g.Streak = 0
+
+		// Odejmij punkty za błędną próbę
+		g.addPoints(g.Scoring.Miss(g))
+
+		// Sprawdź czy przekroczono maksymalną liczbę prób
+		g.checkLoss()
+	}
+
+	g.afterMove()
+
+	// Inteligencja może podsunąć darmową podpowiedź
+	// (odkrycie litery jest zgłaszane słuchaczom po ruchu, który je wywołał)
+	if g.State == Playing && g.rng.Float64() < g.Modifiers.HintChance {
+		if g.AdvisorHints && g.Advisor != nil {
+			if suggestion, ok := g.Advisor.BestLetter(g); ok {
+				g.Triggers = append(g.Triggers, AttributeTrigger{Attribute: TriggerAdvice, Value: int(math.Round(suggestion.Share)), Letter: suggestion.Letter})
 			}
+		} else if revealed, ok := g.revealLetter(); ok {
+			g.Triggers = append(g.Triggers, AttributeTrigger{Attribute: TriggerIntelligence, Letter: revealed})
+			g.afterMove()
+		}
+	}
+
+	return true
+}
+
+// afterMove powiadamia przeciwnika i słuchaczy zdarzeń o zmianie stanu gry
+func (g *Game) afterMove() {
+	if g.adversary != nil {
+		g.adversary.AfterMove(g)
+	}
+	g.emitEvents()
+}
+
+// checkWin kończy grę wygraną, jeśli wszystkie litery zostały odgadnięte
+func (g *Game) checkWin() {
+	for _, char := range g.Word {
+		if !g.isRevealed(char) {
+			return
 		}
+	}
+
+	g.State = Won
+	// Bonus za wygraną (zwykle z bonusem za pozostałe próby)
+	g.addPoints(g.Scoring.Win(g))
+}
+
+// addPoints dodaje punkty przemnożone przez mnożnik poziomu trudności
+func (g *Game) addPoints(points int) {
+	g.Points += int(math.Round(float64(points) * g.Difficulty.ScoreMultiplier))
+}
+
+// checkLoss kończy grę przegraną, jeśli wykorzystano wszystkie próby
+func (g *Game) checkLoss() {
+	if g.UsedAttempts() >= g.MaxAttempts {
+		g.State = Lost
+	}
+}
 
-		if allGuessed {
-			g.State = Won
-			// Bonus za wygraną
-			g.Points += 50
+// GuessWord dokonuje próby odgadnięcia całego słowa
+func (g *Game) GuessWord(word string) bool {
+	// Jeśli gra się skończyła, zwróć false
+	if g.State != Playing {
+		return false
+	}
+
+	normalizedWord := g.Alphabet.FoldWord(word)
+	if normalizedWord == "" {
+		return false
+	}
 
-			// Bonus za pozostałe próby
-			remainingAttempts := g.MaxAttempts - len(g.WrongGuesses)
-			g.Points += remainingAttempts * 5
+	// Tego samego błędnego słowa nie liczymy drugi raz
+	for _, wrong := range g.WrongWords {
+		if g.Alphabet.FoldWord(wrong) == normalizedWord {
+			return false
 		}
+	}
+
+	g.Triggers = nil
+
+	if g.adversary != nil {
+		g.adversary.BeforeWordGuess(g, word)
+	}
+
+	if normalizedWord == g.Alphabet.FoldWord(g.Word) {
+		// Odkryj wszystkie brakujące litery
+		for _, char := range g.Word {
+			if !g.isRevealed(char) {
+				g.GuessedLetters = append(g.GuessedLetters, char)
+			}
+		}
+
+		g.Moves = append(g.Moves, Move{Kind: MoveWordHit, Word: word})
+		g.addPoints(g.WordGuessBonus)
+		g.checkWin()
 	} else {
-		g.WrongGuesses = append(g.WrongGuesses, letter)
+		g.WrongWords = append(g.WrongWords, strings.ToLower(strings.TrimSpace(word)))
+		g.Moves = append(g.Moves, Move{Kind: MoveWordMiss, Word: word})
+		g.PenaltyAttempts += g.WordGuessPenalty
+		g.Streak = 0
 
-		// Odejmij punkty za błędną próbę
-		g.Points -= 5
+		// Odejmij punkty za każdą straconą próbę
+		g.addPoints(g.Scoring.Miss(g) * g.WordGuessPenalty)
+		g.checkLoss()
+	}
 
-		// Sprawdź czy przekroczono maksymalną liczbę prób
-		if len(g.WrongGuesses) >= g.MaxAttempts {
-			g.State = Lost
+	g.afterMove()
+	return true
+}
+
+// UsedAttempts zwraca liczbę wykorzystanych prób
+func (g *Game) UsedAttempts() int {
+	return len(g.WrongGuesses) + g.PenaltyAttempts
+}
+
+// RevealLetter odkrywa losową, jeszcze nieodgadniętą literę słowa
+func (g *Game) RevealLetter() (rune, bool) {
+	letter, ok := g.revealLetter()
+	if ok {
+		g.afterMove()
+	}
+	return letter, ok
+}
+
+// revealLetter odkrywa losową literę bez powiadamiania przeciwnika i słuchaczy zdarzeń
+func (g *Game) revealLetter() (rune, bool) {
+	if g.State != Playing {
+		return 0, false
+	}
+
+	var hidden []rune
+	for _, char := range g.Word {
+		if g.Alphabet.IsLetter(char) && !g.isGuessed(char) {
+			hidden = append(hidden, char)
 		}
 	}
 
+	if len(hidden) == 0 {
+		return 0, false
+	}
+
+	letter := hidden[g.rng.Intn(len(hidden))]
+	g.GuessedLetters = append(g.GuessedLetters, letter)
+	g.Moves = append(g.Moves, Move{Kind: MoveReveal, Letter: letter})
+	g.checkWin()
+
+	return letter, true
+}
+
+// RevealHint odkrywa podpowiedź do słowa za podaną liczbę punktów
+func (g *Game) RevealHint(cost int) bool {
+	if g.State != Playing || g.Hint == "" || g.HintRevealed {
+		return false
+	}
+
+	g.HintRevealed = true
+	g.Points -= cost
+	g.Moves = append(g.Moves, Move{Kind: MoveHintShown})
+	g.emitEvents()
 	return true
 }
 
+// AddAttempts zwiększa maksymalną liczbę prób
+func (g *Game) AddAttempts(attempts int) {
+	if g.State != Playing || attempts <= 0 {
+		return
+	}
+	g.MaxAttempts += attempts
+	g.emitEvents()
+}
+
 // GetRemainingAttempts zwraca liczbę pozostałych prób
 func (g *Game) GetRemainingAttempts() int {
-	return g.MaxAttempts - len(g.WrongGuesses)
+	remaining := g.MaxAttempts - g.UsedAttempts()
+	if remaining < 0 {
+		return 0
+	}
+	return remaining
 }
 
 // GetWrongGuesses zwraca listę błędnych prób
 func (g *Game) GetWrongGuesses() string {
+	return joinLetters(g.WrongGuesses)
+}
+
+// GetForgivenGuesses zwraca listę błędnych prób wybaczonych dzięki szczęściu
+func (g *Game) GetForgivenGuesses() string {
+	return joinLetters(g.ForgivenGuesses)
+}
+
+// joinLetters łączy litery w napis rozdzielony spacjami
+func joinLetters(letters []rune) string {
 	var result strings.Builder
-	for _, letter := range g.WrongGuesses {
+	for _, letter := range letters {
 		result.WriteRune(letter)
 		result.WriteRune(' ')
 	}
@@ -170,12 +475,9 @@ func (g *Game) GetWrongGuesses() string {
 // GetProgress zwraca procentowy postęp odgadnięcia słowa
 func (g *Game) GetProgress() float64 {
 	totalLetters := 0
-	uniqueLetters := make(map[rune]bool)
-
 	for _, char := range g.Word {
-		if IsPolishLetter(char) {
+		if g.Alphabet.IsLetter(char) {
 			totalLetters++
-			uniqueLetters[NormalizeGuess(char)] = true
 		}
 	}
 
@@ -185,7 +487,7 @@ func (g *Game) GetProgress() float64 {
 
 	guessedLetters := 0
 	for _, char := range g.Word {
-		if g.isGuessed(char) {
+		if g.Alphabet.IsLetter(char) && g.isGuessed(char) {
 			guessedLetters++
 		}
 	}
@@ -0,0 +1,171 @@
+package game
+
+import (
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// ModeRace to nazwa trybu wyścigu, w którym kilku graczy odgaduje to samo słowo (zapisywana w statystykach)
+const ModeRace = "race"
+
+// RacePlayer to uczestnik wyścigu z własną grą
+type RacePlayer struct {
+	Name string
+	Game *Game
+}
+
+// RaceStanding opisuje miejsce gracza w wyścigu
+type RaceStanding struct {
+	Rank     int     `json:"rank"`     // Miejsce (gracze z takim samym wynikiem dzielą miejsce)
+	Name     string  `json:"name"`     // Nazwa gracza
+	State    string  `json:"state"`    // Stan gry gracza (State...)
+	Progress float64 `json:"progress"` // Procentowy postęp odgadnięcia słowa
+	Points   int     `json:"points"`   // Punkty zdobyte w grze
+}
+
+// Race to wyścig: każdy gracz ma własną grę z tym samym słowem,
+// a wygrywa ten, kto pierwszy odgadnie całe słowo
+type Race struct {
+	Players []*RacePlayer
+	Turn    int // Indeks gracza, którego jest kolej (przy grze na jednej klawiaturze)
+	winner  int // Indeks zwycięzcy (-1, dopóki nikt nie odgadł słowa)
+}
+
+// NewRace tworzy wyścig dla podanych graczy ze słowem, poziomem trudności, punktacją i ziarnem gry wzorcowej
+// Gracze nie dostają przedmiotów ani bonusów z atrybutów RPG, aby wszyscy mieli równe szanse
+func NewRace(template *Game, names []string) *Race {
+	race := &Race{winner: -1}
+	for _, name := range names {
+		g := NewGame(template.Word, template.Difficulty, GameModifiers{}, template.Scoring)
+		g.Category = template.Category
+		g.Hint = template.Hint
+		g.Language = template.Language
+		g.Alphabet = template.Alphabet
+		g.Mode = ModeRace
+		g.SetSeed(template.Seed)
+
+		race.Players = append(race.Players, &RacePlayer{Name: name, Game: g})
+	}
+	return race
+}
+
+// Guess wykonuje ruch gracza (literę lub całe słowo) w jego grze
+// Zwraca false, jeśli wyścig się zakończył, gra gracza się skończyła albo ruch był nieprawidłowy
+func (r *Race) Guess(player int, input string) bool {
+	if r.Over() || player < 0 || player >= len(r.Players) {
+		return false
+	}
+
+	g := r.Players[player].Game
+	input = strings.TrimSpace(input)
+
+	accepted := false
+	if utf8.RuneCountInString(input) == 1 {
+		letter, _ := utf8.DecodeRuneInString(input)
+		accepted = g.Guess(letter)
+	} else if input != "" {
+		accepted = g.GuessWord(input)
+	}
+
+	if accepted && g.State == Won {
+		r.winner = player
+	}
+	return accepted
+}
+
+// Forfeit kończy grę gracza porażką (np. gdy gracz opuścił wyścig)
+func (r *Race) Forfeit(player int) {
+	if player < 0 || player >= len(r.Players) {
+		return
+	}
+	if g := r.Players[player].Game; g.State == Playing {
+		g.State = Lost
+		g.emitEvents()
+	}
+}
+
+// NextTurn przekazuje kolej następnemu graczowi, którego gra jeszcze trwa
+func (r *Race) NextTurn() {
+	for i := 1; i <= len(r.Players); i++ {
+		next := (r.Turn + i) % len(r.Players)
+		if r.Players[next].Game.State == Playing {
+			r.Turn = next
+			return
+		}
+	}
+}
+
+// Winner zwraca zwycięzcę wyścigu (nil, jeśli nikt jeszcze nie odgadł słowa)
+func (r *Race) Winner() *RacePlayer {
+	if r.winner < 0 {
+		return nil
+	}
+	return r.Players[r.winner]
+}
+
+// Over sprawdza czy wyścig się zakończył: ktoś odgadł słowo albo wszystkie gry się skończyły
+func (r *Race) Over() bool {
+	if r.winner >= 0 {
+		return true
+	}
+	for _, player := range r.Players {
+		if player.Game.State == Playing {
+			return false
+		}
+	}
+	return true
+}
+
+// PlayerIndex zwraca indeks gracza o podanej nazwie (-1, jeśli nie bierze udziału w wyścigu)
+func (r *Race) PlayerIndex(name string) int {
+	for i, player := range r.Players {
+		if player.Name == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// Standings zwraca klasyfikację: najpierw zwycięzca, potem pozostali według postępu i punktów
+func (r *Race) Standings() []RaceStanding {
+	order := make([]int, len(r.Players))
+	for i := range order {
+		order[i] = i
+	}
+
+	sort.SliceStable(order, func(a, b int) bool {
+		return r.ranksBefore(order[a], order[b])
+	})
+
+	standings := make([]RaceStanding, len(order))
+	for i, index := range order {
+		g := r.Players[index].Game
+		standings[i] = RaceStanding{
+			Rank:     i + 1,
+			Name:     r.Players[index].Name,
+			State:    g.State.String(),
+			Progress: g.GetProgress(),
+			Points:   g.Points,
+		}
+
+		// Gracze z takim samym wynikiem dzielą miejsce
+		if i > 0 && !r.ranksBefore(order[i-1], index) {
+			standings[i].Rank = standings[i-1].Rank
+		}
+	}
+	return standings
+}
+
+// ranksBefore sprawdza czy gracz a zajmuje wyższe miejsce niż gracz b
+func (r *Race) ranksBefore(a, b int) bool {
+	if a == r.winner || b == r.winner {
+		return a == r.winner && b != r.winner
+	}
+
+	gameA, gameB := r.Players[a].Game, r.Players[b].Game
+	if progressA, progressB := gameA.GetProgress(), gameB.GetProgress(); progressA != progressB {
+		return progressA > progressB
+	}
+	return gameA.Points > gameB.Points
+}
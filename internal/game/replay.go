@@ -0,0 +1,19 @@
+package game
+
+// NewSeededGame tworzy grę z podanym słowem startowym, trybem i ziarnem losowości
+// Gra utworzona ponownie z tymi samymi danymi (np. zapisanymi w statystykach) przy tych samych
+// ruchach przebiega identycznie: ma te same słowa złośliwego wisielca i te same efekty atrybutów
+func NewSeededGame(word Word, pack []Word, mode string, difficulty Difficulty, modifiers GameModifiers, scoring ScoringPolicy, seed int64) *Game {
+	var g *Game
+	switch mode {
+	case ModeEvil:
+		g = NewEvilGame(word, pack, difficulty, modifiers, scoring)
+	default:
+		g = NewGameFromWord(word, difficulty, modifiers, scoring)
+		g.Mode = mode
+	}
+
+	g.SetSeed(seed)
+	g.Advisor = NewAdvisor(pack)
+	return g
+}
@@ -0,0 +1,83 @@
+package game
+
+import (
+	"reflect"
+	"testing"
+)
+
+// replayPack to paczka słów o tym samym kształcie, aby tryb złośliwego wisielca miał z czego wybierać
+var replayPack = []Word{
+	{Text: "kotek", Language: "pl"},
+	{Text: "młotek", Language: "pl"},
+	{Text: "kwiat", Language: "pl"},
+	{Text: "lasek", Language: "pl"},
+	{Text: "piesek", Language: "pl"},
+	{Text: "domek", Language: "pl"},
+	{Text: "kubek", Language: "pl"},
+	{Text: "listy", Language: "pl"},
+}
+
+// playReplayMoves rozgrywa grę podanymi ruchami (litery i całe słowa) aż do jej zakończenia
+func playReplayMoves(g *Game, moves []string) {
+	for _, move := range moves {
+		if g.State != Playing {
+			return
+		}
+		if len([]rune(move)) > 1 {
+			g.GuessWord(move)
+		} else {
+			g.Guess([]rune(move)[0])
+		}
+	}
+}
+
+func TestSeededGameReplay(t *testing.T) {
+	difficulty := Difficulty{ID: "medium", Attempts: 6}
+	moves := []string{"z", "c", "b", "e", "a", "k", "o", "s", "t", "i", "kotek", "d", "m", "l", "u", "y", "w"}
+
+	tests := []struct {
+		mode      string
+		start     Word
+		modifiers GameModifiers
+	}{
+		{"", replayPack[0], GameModifiers{ExtraAttempts: 1, PointsPerHit: 2, ForgiveChance: 0.5, HintChance: 0.5}},
+		{ModeEvil, replayPack[2], GameModifiers{ExtraAttempts: 1, PointsPerHit: 2, ForgiveChance: 0.5}},
+	}
+
+	for _, tt := range tests {
+		original := NewSeededGame(tt.start, replayPack, tt.mode, difficulty, tt.modifiers, RarityScoring{}, 42)
+		playReplayMoves(original, moves)
+		if len(original.ForgivenGuesses) == 0 {
+			t.Fatalf("tryb %q: żaden błąd nie został wybaczony, test nie sprawdza losowych efektów", tt.mode)
+		}
+		if tt.mode == ModeEvil && original.Word == original.StartWord {
+			t.Fatalf("tryb %q: słowo %q nie zmieniło się w trakcie gry", tt.mode, original.Word)
+		}
+
+		// Odtworzenie korzysta tylko z tego, co zapisują statystyki: słowa startowego, ustawień i ziarna
+		start, _ := findTestWord(original.StartWord)
+		replay := NewSeededGame(start, replayPack, original.Mode, difficulty, original.Modifiers, RarityScoring{}, original.Seed)
+		playReplayMoves(replay, moves)
+
+		if !reflect.DeepEqual(replay.Moves, original.Moves) {
+			t.Errorf("tryb %q: ruchy odtworzenia = %+v, oczekiwano %+v", tt.mode, replay.Moves, original.Moves)
+		}
+		if replay.Word != original.Word || replay.Points != original.Points || replay.State != original.State {
+			t.Errorf("tryb %q: odtworzenie = (%s, %d pkt, %s), oczekiwano (%s, %d pkt, %s)", tt.mode,
+				replay.Word, replay.Points, replay.State, original.Word, original.Points, original.State)
+		}
+		if !reflect.DeepEqual(replay.ForgivenGuesses, original.ForgivenGuesses) {
+			t.Errorf("tryb %q: wybaczone litery = %q, oczekiwano %q", tt.mode, replay.ForgivenGuesses, original.ForgivenGuesses)
+		}
+	}
+}
+
+// findTestWord szuka słowa w paczce testowej
+func findTestWord(text string) (Word, bool) {
+	for _, word := range replayPack {
+		if word.Text == text {
+			return word, true
+		}
+	}
+	return Word{Text: text, Language: "pl"}, false
+}
@@ -7,56 +7,56 @@ import (
 
 // RPGLevel reprezentuje poziom gracza w systemie RPG
 type RPGLevel struct {
-	Level       int            // Aktualny poziom gracza
-	Experience  int            // Aktualne doświadczenie
-	NextLevelXP int            // Wymagane doświadczenie do następnego poziomu
-	Attributes  *RPGAttributes // Atrybuty gracza
-	Inventory   *RPGInventory  // Ekwipunek gracza
+	Level       int            `json:"level"`         // Aktualny poziom gracza
+	Experience  int            `json:"experience"`    // Aktualne doświadczenie
+	NextLevelXP int            `json:"next_level_xp"` // Wymagane doświadczenie do następnego poziomu
+	Attributes  *RPGAttributes `json:"attributes"`    // Atrybuty gracza
+	Inventory   *RPGInventory  `json:"inventory"`     // Ekwipunek gracza
 }
 
 // RPGAttributes reprezentuje atrybuty gracza
 type RPGAttributes struct {
-	Intelligence int // Inteligencja - zwiększa szansę na podpowiedź
-	Luck         int // Szczęście - zmniejsza szansę na utratę życia przy błędzie
-	Perception   int // Percepcja - zwiększa liczbę punktów za odgadnięcie litery
-	Resilience   int // Odporność - dodaje dodatkowe próby
+	Intelligence int `json:"intelligence"` // Inteligencja - zwiększa szansę na podpowiedź
+	Luck         int `json:"luck"`         // Szczęście - zmniejsza szansę na utratę życia przy błędzie
+	Perception   int `json:"perception"`   // Percepcja - zwiększa liczbę punktów za odgadnięcie litery
+	Resilience   int `json:"resilience"`   // Odporność - dodaje dodatkowe próby
 }
 
 // RPGItem reprezentuje przedmiot w grze
 type RPGItem struct {
-	ID          string          // Unikalny identyfikator przedmiotu
-	Name        string          // Nazwa przedmiotu
-	Description string          // Opis przedmiotu
-	Type        string          // Typ przedmiotu (consumable, equipment, etc.)
-	Rarity      string          // Rzadkość przedmiotu (common, rare, epic, legendary)
-	Effects     []RPGItemEffect // Efekty przedmiotu
-	Used        bool            // Czy przedmiot został już użyty
+	ID          string          `json:"id"`          // Unikalny identyfikator przedmiotu
+	Name        string          `json:"name"`        // Nazwa przedmiotu
+	Description string          `json:"description"` // Opis przedmiotu
+	Type        string          `json:"type"`        // Typ przedmiotu (consumable, equipment, etc.)
+	Rarity      string          `json:"rarity"`      // Rzadkość przedmiotu (common, rare, epic, legendary)
+	Effects     []RPGItemEffect `json:"effects"`     // Efekty przedmiotu
+	Used        bool            `json:"used"`        // Czy przedmiot został już użyty
 }
 
 // RPGItemEffect reprezentuje efekt przedmiotu
 type RPGItemEffect struct {
-	Type      string    // Typ efektu (reveal_letter, extra_life, etc.)
-	Value     int       // Wartość efektu
-	Duration  int       // Czas trwania efektu (0 = jednorazowy, >0 = liczba tur)
-	ExpiresAt time.Time // Czas wygaśnięcia efektu
+	Type      string    `json:"type"`       // Typ efektu (reveal_letter, extra_life, etc.)
+	Value     int       `json:"value"`      // Wartość efektu
+	Duration  int       `json:"duration"`   // Czas trwania efektu (0 = jednorazowy, >0 = liczba tur)
+	ExpiresAt time.Time `json:"expires_at"` // Czas wygaśnięcia efektu
 }
 
 // RPGInventory reprezentuje ekwipunek gracza
 type RPGInventory struct {
-	Items       []RPGItem // Lista przedmiotów w ekwipunku
-	MaxCapacity int       // Maksymalna liczba przedmiotów w ekwipunku
+	Items       []RPGItem `json:"items"`        // Lista przedmiotów w ekwipunku
+	MaxCapacity int       `json:"max_capacity"` // Maksymalna liczba przedmiotów w ekwipunku
 }
 
 // RPGQuest reprezentuje zadanie w grze
 type RPGQuest struct {
-	ID          string // Unikalny identyfikator zadania
-	Name        string // Nazwa zadania
-	Description string // Opis zadania
-	Objective   string // Cel zadania (np. "Odgadnij 5 słów")
-	Progress    int    // Postęp w wykonaniu zadania
-	Target      int    // Docelowa wartość do osiągnięcia
-	Completed   bool   // Czy zadanie zostało ukończone
-	Reward      int    // Nagroda XP za ukończenie zadania
+	ID          string `json:"id"`          // Unikalny identyfikator zadania
+	Name        string `json:"name"`        // Nazwa zadania
+	Description string `json:"description"` // Opis zadania
+	Objective   string `json:"objective"`   // Cel zadania (np. "Odgadnij 5 słów")
+	Progress    int    `json:"progress"`    // Postęp w wykonaniu zadania
+	Target      int    `json:"target"`      // Docelowa wartość do osiągnięcia
+	Completed   bool   `json:"completed"`   // Czy zadanie zostało ukończone
+	Reward      int    `json:"reward"`      // Nagroda XP za ukończenie zadania
 }
 
 // NewRPGLevel tworzy nowy obiekt poziomu RPG
@@ -117,9 +117,21 @@ func (rl *RPGLevel) AddAttributePoints(points int) {
 	rl.Attributes.Intelligence += remainder
 }
 
+// ItemCount zwraca liczbę przedmiotów zajmujących miejsce w ekwipunku
+// (zużyte przedmioty jednorazowe zostają na liście, ale nie zajmują miejsca)
+func (inv *RPGInventory) ItemCount() int {
+	count := 0
+	for _, item := range inv.Items {
+		if !item.Used {
+			count++
+		}
+	}
+	return count
+}
+
 // AddItem dodaje przedmiot do ekwipunku
 func (inv *RPGInventory) AddItem(item RPGItem) bool {
-	if len(inv.Items) >= inv.MaxCapacity {
+	if inv.ItemCount() >= inv.MaxCapacity {
 		return false // Ekwipunek pełny
 	}
 
@@ -146,6 +158,67 @@ func (inv *RPGInventory) UseItem(itemID string) ([]RPGItemEffect, bool) {
 	return nil, false
 }
 
+// GetUsableItems zwraca przedmioty, których można użyć w trakcie gry
+func (inv *RPGInventory) GetUsableItems() []RPGItem {
+	var usable []RPGItem
+	for _, item := range inv.Items {
+		if item.Type == "consumable" && !item.Used {
+			usable = append(usable, item)
+		}
+	}
+	return usable
+}
+
+// ItemEffectResult opisuje wynik zastosowania efektu przedmiotu w grze
+type ItemEffectResult struct {
+	Effect  RPGItemEffect // Zastosowany efekt
+	Applied bool          // Czy efekt zadziałał
+	Letters []rune        // Odkryte litery (dla reveal_letter) lub litery podsunięte przez doradcę (dla suggest_letter)
+}
+
+// ApplyItemEffects stosuje efekty przedmiotu do trwającej gry
+func ApplyItemEffects(g *Game, effects []RPGItemEffect) []ItemEffectResult {
+	results := make([]ItemEffectResult, 0, len(effects))
+	g.Triggers = nil
+
+	for _, effect := range effects {
+		result := ItemEffectResult{Effect: effect}
+
+		switch effect.Type {
+		case "reveal_letter":
+			for i := 0; i < effect.Value; i++ {
+				letter, ok := g.RevealLetter()
+				if !ok {
+					break
+				}
+				result.Letters = append(result.Letters, letter)
+			}
+			result.Applied = len(result.Letters) > 0
+		case "reveal_hint":
+			result.Applied = g.RevealHint(0)
+		case "suggest_letter":
+			if g.State == Playing && g.Advisor != nil {
+				for i, suggestion := range g.Advisor.Suggest(g) {
+					if i >= effect.Value {
+						break
+					}
+					result.Letters = append(result.Letters, suggestion.Letter)
+				}
+			}
+			result.Applied = len(result.Letters) > 0
+		case "extra_life":
+			if g.State == Playing && effect.Value > 0 {
+				g.AddAttempts(effect.Value)
+				result.Applied = true
+			}
+		}
+
+		results = append(results, result)
+	}
+
+	return results
+}
+
 // GetIntelligenceBonus zwraca bonus za inteligencję (szansa na podpowiedź)
 func (attr *RPGAttributes) GetIntelligenceBonus() float64 {
 	// Każdy punkt inteligencji daje 2% szansy na podpowiedź
@@ -170,6 +243,44 @@ func (attr *RPGAttributes) GetResilienceBonus() int {
 	return attr.Resilience / 3
 }
 
+// GetEffectiveAttributes zwraca atrybuty gracza powiększone o bonusy z wyposażenia
+func (rl *RPGLevel) GetEffectiveAttributes() RPGAttributes {
+	attributes := *rl.Attributes
+
+	for _, item := range rl.Inventory.Items {
+		if item.Type != "equipment" {
+			continue
+		}
+
+		for _, effect := range item.Effects {
+			switch effect.Type {
+			case "intelligence_boost":
+				attributes.Intelligence += effect.Value
+			case "luck_boost":
+				attributes.Luck += effect.Value
+			case "perception_boost":
+				attributes.Perception += effect.Value
+			case "resilience_boost":
+				attributes.Resilience += effect.Value
+			}
+		}
+	}
+
+	return attributes
+}
+
+// GetGameModifiers zwraca modyfikatory rozgrywki wynikające z atrybutów gracza
+func (rl *RPGLevel) GetGameModifiers() GameModifiers {
+	attributes := rl.GetEffectiveAttributes()
+
+	return GameModifiers{
+		ExtraAttempts: attributes.GetResilienceBonus(),
+		PointsPerHit:  attributes.GetPerceptionBonus(),
+		ForgiveChance: attributes.GetLuckBonus(),
+		HintChance:    attributes.GetIntelligenceBonus(),
+	}
+}
+
 // GetXPProgress zwraca procentowy postęp do następnego poziomu
 func (rl *RPGLevel) GetXPProgress() float64 {
 	return math.Min(float64(rl.Experience)/float64(rl.NextLevelXP)*100, 100)
@@ -191,6 +302,32 @@ func GenerateBasicItems() []RPGItem {
 				},
 			},
 		},
+		{
+			ID:          "scroll_hint",
+			Name:        "Zwój Wiedzy",
+			Description: "Odkrywa podpowiedź do aktualnego słowa",
+			Type:        "consumable",
+			Rarity:      "common",
+			Effects: []RPGItemEffect{
+				{
+					Type:  "reveal_hint",
+					Value: 1,
+				},
+			},
+		},
+		{
+			ID:          "crystal_ball",
+			Name:        "Kryształowa Kula",
+			Description: "Podsuwa litery, które najczęściej występują w pasujących słowach",
+			Type:        "consumable",
+			Rarity:      "common",
+			Effects: []RPGItemEffect{
+				{
+					Type:  "suggest_letter",
+					Value: 2,
+				},
+			},
+		},
 		{
 			ID:          "scroll_extra_life",
 			Name:        "Zwój Dodatkowego Życia",
@@ -240,7 +377,7 @@ func GenerateBasicQuests() []RPGQuest {
 			ID:          "quest_novice",
 			Name:        "Początkujący Odgadywacz",
 			Description: "Odgadnij poprawnie 3 słowa",
-			Objective:   "win_games",
+			Objective:   QuestWinGames,
 			Progress:    0,
 			Target:      3,
 			Completed:   false,
@@ -250,7 +387,7 @@ func GenerateBasicQuests() []RPGQuest {
 			ID:          "quest_perfect",
 			Name:        "Perfekcyjna Gra",
 			Description: "Odgadnij słowo bez żadnego błędu",
-			Objective:   "perfect_game",
+			Objective:   QuestPerfectGame,
 			Progress:    0,
 			Target:      1,
 			Completed:   false,
@@ -260,7 +397,7 @@ func GenerateBasicQuests() []RPGQuest {
 			ID:          "quest_difficult",
 			Name:        "Mistrz Trudności",
 			Description: "Wygraj grę na trudnym poziomie",
-			Objective:   "win_hard",
+			Objective:   QuestWinHard,
 			Progress:    0,
 			Target:      1,
 			Completed:   false,
@@ -269,6 +406,56 @@ func GenerateBasicQuests() []RPGQuest {
 	}
 }
 
+// Cele zadań odpowiadające zdarzeniom z gry
+const (
+	QuestWinGames    = "win_games"    // Wygrana gra
+	QuestPerfectGame = "perfect_game" // Wygrana bez żadnego błędu
+	QuestWinHard     = "win_hard"     // Wygrana na trudnym poziomie
+)
+
+// GetQuestEvents zwraca zdarzenia zadań wynikające z zakończonej gry
+func GetQuestEvents(g *Game) []string {
+	if g.State != Won {
+		return nil
+	}
+
+	events := []string{QuestWinGames}
+	if g.UsedAttempts() == 0 {
+		events = append(events, QuestPerfectGame)
+	}
+	if g.Difficulty.ID == DifficultyHard {
+		events = append(events, QuestWinHard)
+	}
+
+	return events
+}
+
+// ApplyQuestEvents aktualizuje zadania zdarzeniami z gry i zwraca zadania ukończone właśnie teraz
+func ApplyQuestEvents(quests []RPGQuest, events []string) ([]RPGQuest, []RPGQuest, int) {
+	var completed []RPGQuest
+	totalXP := 0
+
+	for _, event := range events {
+		wasCompleted := make([]bool, len(quests))
+		for i, quest := range quests {
+			wasCompleted[i] = quest.Completed
+		}
+
+		var xp int
+		quests, xp = UpdateQuests(quests, event, 1)
+		totalXP += xp
+
+		// Zadanie ukończone wcześniej nie jest zgłaszane ponownie
+		for i, quest := range quests {
+			if quest.Completed && !wasCompleted[i] {
+				completed = append(completed, quest)
+			}
+		}
+	}
+
+	return quests, completed, totalXP
+}
+
 // UpdateQuests aktualizuje postęp w zadaniach
 func UpdateQuests(quests []RPGQuest, eventType string, value int) ([]RPGQuest, int) {
 	totalXP := 0
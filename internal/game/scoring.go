@@ -0,0 +1,134 @@
+package game
+
+import (
+	"math"
+)
+
+// Nazwy dostępnych zasad punktacji
+const (
+	ClassicScoringName    = "classic"
+	WordLengthScoringName = "length"
+	RarityScoringName     = "rarity"
+	StreakScoringName     = "streak"
+
+	DefaultScoringPolicy = ClassicScoringName
+)
+
+// ScoringPolicy określa zasady przyznawania punktów w grze
+type ScoringPolicy interface {
+	// Name zwraca stałą nazwę zasad (zapisywaną w statystykach)
+	Name() string
+	// LetterHit zwraca punkty za trafioną literę
+	LetterHit(g *Game, letter rune) int
+	// Miss zwraca punkty (zwykle ujemne) za każdą straconą próbę
+	Miss(g *Game) int
+	// Win zwraca bonus za wygraną
+	Win(g *Game) int
+}
+
+// ClassicScoring to klasyczne zasady: +10 za literę, -5 za błąd, +50 za wygraną i +5 za każdą niewykorzystaną próbę
+type ClassicScoring struct{}
+
+// Name zwraca nazwę zasad
+func (ClassicScoring) Name() string { return ClassicScoringName }
+
+// LetterHit zwraca punkty za trafioną literę
+func (ClassicScoring) LetterHit(g *Game, letter rune) int { return 10 }
+
+// Miss zwraca punkty za straconą próbę
+func (ClassicScoring) Miss(g *Game) int { return -5 }
+
+// Win zwraca bonus za wygraną
+func (ClassicScoring) Win(g *Game) int { return 50 + g.GetRemainingAttempts()*5 }
+
+// WordLengthScoring skaluje punkty długością słowa (słowo 6-literowe daje tyle co klasyczne zasady)
+type WordLengthScoring struct{}
+
+// Name zwraca nazwę zasad
+func (WordLengthScoring) Name() string { return WordLengthScoringName }
+
+// lengthFactor zwraca mnożnik punktów dla długości słowa
+func (WordLengthScoring) lengthFactor(g *Game) float64 {
+	return math.Max(float64(g.Alphabet.CountLetters(g.Word))/6, 0.5)
+}
+
+// LetterHit zwraca punkty za trafioną literę
+func (s WordLengthScoring) LetterHit(g *Game, letter rune) int {
+	return int(math.Round(10 * s.lengthFactor(g)))
+}
+
+// Miss zwraca punkty za straconą próbę
+func (WordLengthScoring) Miss(g *Game) int { return -5 }
+
+// Win zwraca bonus za wygraną
+func (s WordLengthScoring) Win(g *Game) int {
+	return int(math.Round(50*s.lengthFactor(g))) + g.GetRemainingAttempts()*5
+}
+
+// RarityScoring przyznaje więcej punktów za rzadkie litery
+type RarityScoring struct{}
+
+// Name zwraca nazwę zasad
+func (RarityScoring) Name() string { return RarityScoringName }
+
+// LetterHit zwraca punkty za trafioną literę (od 5 za najczęstsze do 20 za najrzadsze)
+func (RarityScoring) LetterHit(g *Game, letter rune) int {
+	return 5 + int(math.Round(15*g.Alphabet.LetterRarity(letter)))
+}
+
+// Miss zwraca punkty za straconą próbę
+func (RarityScoring) Miss(g *Game) int { return -5 }
+
+// Win zwraca bonus za wygraną
+func (RarityScoring) Win(g *Game) int { return 50 + g.GetRemainingAttempts()*5 }
+
+// StreakScoring mnoży punkty za literę przez serię kolejnych trafień (maksymalnie x5)
+type StreakScoring struct{}
+
+// Name zwraca nazwę zasad
+func (StreakScoring) Name() string { return StreakScoringName }
+
+// LetterHit zwraca punkty za trafioną literę
+func (StreakScoring) LetterHit(g *Game, letter rune) int {
+	multiplier := g.Streak
+	if multiplier < 1 {
+		multiplier = 1
+	}
+	if multiplier > 5 {
+		multiplier = 5
+	}
+	return 10 * multiplier
+}
+
+// Miss zwraca punkty za straconą próbę
+func (StreakScoring) Miss(g *Game) int { return -5 }
+
+// Win zwraca bonus za wygraną
+func (StreakScoring) Win(g *Game) int { return 50 + g.GetRemainingAttempts()*5 }
+
+// scoringPolicies zawiera wszystkie dostępne zasady punktacji
+var scoringPolicies = []ScoringPolicy{
+	ClassicScoring{},
+	WordLengthScoring{},
+	RarityScoring{},
+	StreakScoring{},
+}
+
+// GetScoringPolicy zwraca zasady punktacji o podanej nazwie
+func GetScoringPolicy(name string) (ScoringPolicy, bool) {
+	for _, policy := range scoringPolicies {
+		if policy.Name() == name {
+			return policy, true
+		}
+	}
+	return nil, false
+}
+
+// ScoringPolicyNames zwraca nazwy wszystkich dostępnych zasad punktacji
+func ScoringPolicyNames() []string {
+	names := make([]string, 0, len(scoringPolicies))
+	for _, policy := range scoringPolicies {
+		names = append(names, policy.Name())
+	}
+	return names
+}
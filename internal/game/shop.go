@@ -0,0 +1,45 @@
+package game
+
+import (
+	"errors"
+)
+
+// ItemPrices zawiera ceny przedmiotów w XP (zależne od rzadkości)
+var ItemPrices = map[string]int{
+	"common":    50,
+	"uncommon":  100,
+	"rare":      250,
+	"epic":      500,
+	"legendary": 1000,
+}
+
+// Błędy zakupu przedmiotu
+var (
+	ErrNotEnoughXP   = errors.New("za mało doświadczenia")
+	ErrInventoryFull = errors.New("ekwipunek jest pełny")
+)
+
+// GetItemPrice zwraca cenę przedmiotu w XP
+func GetItemPrice(item RPGItem) int {
+	return ItemPrices[item.Rarity]
+}
+
+// CanAfford sprawdza, czy gracza stać na przedmiot
+func (rl *RPGLevel) CanAfford(item RPGItem) bool {
+	return rl.Experience >= GetItemPrice(item)
+}
+
+// BuyItem kupuje przedmiot za doświadczenie i dodaje go do ekwipunku
+func (rl *RPGLevel) BuyItem(item RPGItem) error {
+	if !rl.CanAfford(item) {
+		return ErrNotEnoughXP
+	}
+
+	// Najpierw dodaj przedmiot, aby nie pobrać opłaty przy pełnym ekwipunku
+	if !rl.Inventory.AddItem(item) {
+		return ErrInventoryFull
+	}
+
+	rl.Experience -= GetItemPrice(item)
+	return nil
+}
@@ -0,0 +1,33 @@
+package game
+
+import "testing"
+
+func TestBuyItemAfterUsingConsumable(t *testing.T) {
+	rl := NewRPGLevel()
+	rl.Experience = 10000
+	potion := RPGItem{ID: "potion", Name: "Mikstura", Type: "consumable", Rarity: "common"}
+
+	for i := 0; i < rl.Inventory.MaxCapacity; i++ {
+		if err := rl.BuyItem(potion); err != nil {
+			t.Fatalf("zakup %d: %v", i+1, err)
+		}
+	}
+	if err := rl.BuyItem(potion); err != ErrInventoryFull {
+		t.Fatalf("zakup przy pełnym ekwipunku: %v, oczekiwano %v", err, ErrInventoryFull)
+	}
+
+	if _, ok := rl.Inventory.UseItem("potion"); !ok {
+		t.Fatal("nie udało się użyć przedmiotu")
+	}
+	if count := rl.Inventory.ItemCount(); count != rl.Inventory.MaxCapacity-1 {
+		t.Errorf("ItemCount() = %d, oczekiwano %d", count, rl.Inventory.MaxCapacity-1)
+	}
+
+	experience := rl.Experience
+	if err := rl.BuyItem(potion); err != nil {
+		t.Fatalf("zakup po użyciu przedmiotu: %v", err)
+	}
+	if spent := experience - rl.Experience; spent != GetItemPrice(potion) {
+		t.Errorf("pobrano %d XP, oczekiwano %d", spent, GetItemPrice(potion))
+	}
+}
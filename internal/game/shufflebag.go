@@ -0,0 +1,64 @@
+package game
+
+// ShuffleBag pamięta słowa, które nie zostały jeszcze wylosowane z każdej paczki,
+// dzięki czemu słowo powtarza się dopiero po przejściu przez całą paczkę
+type ShuffleBag struct {
+	Remaining map[string][]string `json:"remaining"` // Niewylosowane słowa (uproszczone alfabetem paczki) według kodu języka paczki
+}
+
+// NewShuffleBag tworzy pusty worek słów
+func NewShuffleBag() *ShuffleBag {
+	return &ShuffleBag{Remaining: make(map[string][]string)}
+}
+
+// Contains sprawdza czy słowo jest jeszcze w worku paczki
+func (b *ShuffleBag) Contains(pack string, word string) bool {
+	key := wordKey(pack, word)
+	for _, remaining := range b.Remaining[pack] {
+		if remaining == key {
+			return true
+		}
+	}
+	return false
+}
+
+// IsInitialized sprawdza czy worek paczki został już napełniony
+func (b *ShuffleBag) IsInitialized(pack string) bool {
+	_, ok := b.Remaining[pack]
+	return ok
+}
+
+// Remove wyjmuje słowo z worka paczki
+func (b *ShuffleBag) Remove(pack string, word string) {
+	key := wordKey(pack, word)
+	remaining := b.Remaining[pack]
+	for i, candidate := range remaining {
+		if candidate == key {
+			b.Remaining[pack] = append(remaining[:i], remaining[i+1:]...)
+			return
+		}
+	}
+}
+
+// PutBack wkłada do worka paczki te z podanych słów, których w nim nie ma
+// (pozostałe słowa paczki zostają wylosowane)
+func (b *ShuffleBag) PutBack(pack string, words []Word) {
+	for _, word := range words {
+		if !b.Contains(pack, word.Text) {
+			b.Remaining[pack] = append(b.Remaining[pack], wordKey(pack, word.Text))
+		}
+	}
+}
+
+// Refill napełnia worek paczki wszystkimi słowami
+func (b *ShuffleBag) Refill(pack string, words []Word) {
+	if b.Remaining == nil {
+		b.Remaining = make(map[string][]string)
+	}
+
+	remaining := make([]string, 0, len(words))
+	for _, word := range words {
+		remaining = append(remaining, wordKey(pack, word.Text))
+	}
+	b.Remaining[pack] = remaining
+}
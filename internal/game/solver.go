@@ -0,0 +1,151 @@
+package game
+
+import (
+	"math/rand"
+	"sort"
+)
+
+// Nazwy dostępnych strategii automatycznego gracza
+const (
+	FrequencyStrategyName = "frequency"
+	CandidateStrategyName = "candidates"
+	RandomStrategyName    = "random"
+)
+
+// SolverStrategy określa, jaką literę automatyczny gracz poda w następnym ruchu
+type SolverStrategy interface {
+	// Name zwraca stałą nazwę strategii
+	Name() string
+	// NextLetter zwraca następną literę do podania (false, jeśli strategia nie ma już liter)
+	NextLetter(g *Game) (rune, bool)
+}
+
+// FrequencyStrategy podaje litery w stałej kolejności: od występujących w największej liczbie słów paczki
+type FrequencyStrategy struct {
+	order []rune // Litery posortowane według liczby słów paczki, w których występują
+}
+
+// NewFrequencyStrategy tworzy strategię kolejności częstości liter dla paczki słów
+func NewFrequencyStrategy(words []Word) *FrequencyStrategy {
+	counts := make(map[rune]int)
+	for _, word := range words {
+		alphabet := GetAlphabet(word.Language)
+		seen := make(map[rune]bool)
+		for _, char := range word.Text {
+			if !alphabet.IsLetter(char) {
+				continue
+			}
+			// Litery ze znakami diakrytycznymi liczą się razem z literą podstawową
+			if folded := []rune(alphabet.Fold(char)); len(folded) == 1 {
+				char = folded[0]
+			}
+			if !seen[char] {
+				seen[char] = true
+				counts[char]++
+			}
+		}
+	}
+
+	order := make([]rune, 0, len(counts))
+	for letter := range counts {
+		order = append(order, letter)
+	}
+	sort.Slice(order, func(i, j int) bool {
+		if counts[order[i]] != counts[order[j]] {
+			return counts[order[i]] > counts[order[j]]
+		}
+		return order[i] < order[j]
+	})
+
+	return &FrequencyStrategy{order: order}
+}
+
+// Name zwraca nazwę strategii
+func (*FrequencyStrategy) Name() string { return FrequencyStrategyName }
+
+// NextLetter zwraca najczęstszą jeszcze niepodaną literę
+func (s *FrequencyStrategy) NextLetter(g *Game) (rune, bool) {
+	for _, letter := range s.order {
+		if g.Alphabet.IsLetter(letter) && !g.isGuessed(letter) && !g.isWrongGuess(letter) {
+			return letter, true
+		}
+	}
+	return 0, false
+}
+
+// CandidateStrategy podaje literę występującą w największej liczbie słów zgodnych ze stanem gry
+type CandidateStrategy struct {
+	advisor *Advisor
+}
+
+// NewCandidateStrategy tworzy strategię filtrowania kandydatów dla paczki słów
+func NewCandidateStrategy(words []Word) *CandidateStrategy {
+	return &CandidateStrategy{advisor: NewAdvisor(words)}
+}
+
+// Name zwraca nazwę strategii
+func (*CandidateStrategy) Name() string { return CandidateStrategyName }
+
+// NextLetter zwraca literę podsuniętą przez doradcę
+func (s *CandidateStrategy) NextLetter(g *Game) (rune, bool) {
+	suggestion, ok := s.advisor.BestLetter(g)
+	return suggestion.Letter, ok
+}
+
+// RandomStrategy podaje losowe, jeszcze niepodane litery alfabetu
+type RandomStrategy struct {
+	rng *rand.Rand
+}
+
+// NewRandomStrategy tworzy strategię losowych liter
+func NewRandomStrategy(source rand.Source) *RandomStrategy {
+	return &RandomStrategy{rng: rand.New(source)}
+}
+
+// Name zwraca nazwę strategii
+func (*RandomStrategy) Name() string { return RandomStrategyName }
+
+// NextLetter zwraca losową literę, która nie była jeszcze podana
+func (s *RandomStrategy) NextLetter(g *Game) (rune, bool) {
+	var letters []rune
+	for _, letter := range g.Alphabet.Letters {
+		if !g.isGuessed(letter) && !g.isWrongGuess(letter) {
+			letters = append(letters, letter)
+		}
+	}
+	if len(letters) == 0 {
+		return 0, false
+	}
+	return letters[s.rng.Intn(len(letters))], true
+}
+
+// SolverStrategyNames zwraca nazwy wszystkich dostępnych strategii
+func SolverStrategyNames() []string {
+	return []string{FrequencyStrategyName, CandidateStrategyName, RandomStrategyName}
+}
+
+// NewSolverStrategy tworzy strategię o podanej nazwie dla paczki słów
+func NewSolverStrategy(name string, words []Word, source rand.Source) (SolverStrategy, bool) {
+	switch name {
+	case FrequencyStrategyName:
+		return NewFrequencyStrategy(words), true
+	case CandidateStrategyName:
+		return NewCandidateStrategy(words), true
+	case RandomStrategyName:
+		return NewRandomStrategy(source), true
+	default:
+		return nil, false
+	}
+}
+
+// Solve rozgrywa grę do końca, podając litery wybrane przez strategię
+func Solve(g *Game, strategy SolverStrategy) {
+	for g.State == Playing {
+		letter, ok := strategy.NextLetter(g)
+		if !ok || !g.Guess(letter) {
+			// Strategia nie ma już liter (lub podała niedozwoloną) - gra kończy się przegraną
+			g.State = Lost
+			return
+		}
+	}
+}
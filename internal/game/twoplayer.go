@@ -0,0 +1,18 @@
+package game
+
+// ModeTwoPlayer to nazwa trybu dwuosobowego, w którym słowo wpisuje drugi gracz (zapisywana w statystykach)
+const ModeTwoPlayer = "two_player"
+
+// NewTwoPlayerGame tworzy grę dwuosobową ze słowem wpisanym przez gracza
+// Słowo jest normalizowane i sprawdzane filtrem słów (alfabet języka i liczba liter);
+// jeśli filtr znajdzie problemy, gra nie jest tworzona
+func NewTwoPlayerGame(secret string, filter WordFilter, difficulty Difficulty, scoring ScoringPolicy) (*Game, []WordIssue) {
+	text := CleanWordText(secret)
+	if issues := filter.Check(text); len(issues) > 0 {
+		return nil, issues
+	}
+
+	g := NewGameFromWord(Word{Text: text, Language: filter.Alphabet.Language}, difficulty, GameModifiers{}, scoring)
+	g.Mode = ModeTwoPlayer
+	return g, nil
+}
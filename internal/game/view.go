@@ -0,0 +1,68 @@
+package game
+
+// Nazwy stanów gry używane poza silnikiem (np. w protokole sieciowym)
+const (
+	StatePlaying = "playing"
+	StateWon     = "won"
+	StateLost    = "lost"
+)
+
+// String zwraca nazwę stanu gry
+func (s GameState) String() string {
+	switch s {
+	case Won:
+		return StateWon
+	case Lost:
+		return StateLost
+	default:
+		return StatePlaying
+	}
+}
+
+// GameView to widok gry z perspektywy gracza: zawiera tylko to, co gracz może zobaczyć
+// (słowo jest ujawniane dopiero po zakończeniu gry)
+type GameView struct {
+	Pattern           string             `json:"pattern"`                    // Słowo z odkrytymi literami
+	Category          string             `json:"category,omitempty"`         // Kategoria słowa
+	Hint              string             `json:"hint,omitempty"`             // Podpowiedź (tylko odkryta)
+	Language          string             `json:"language,omitempty"`         // Kod języka słowa
+	WrongGuesses      string             `json:"wrong_guesses,omitempty"`    // Błędne próby
+	WrongWords        []string           `json:"wrong_words,omitempty"`      // Błędnie podane całe słowa
+	ForgivenGuesses   string             `json:"forgiven_guesses,omitempty"` // Błędy wybaczone dzięki szczęściu
+	UsedAttempts      int                `json:"used_attempts"`
+	RemainingAttempts int                `json:"remaining_attempts"`
+	MaxAttempts       int                `json:"max_attempts"`
+	Points            int                `json:"points"`
+	Progress          float64            `json:"progress"` // Procentowy postęp odgadnięcia słowa
+	State             string             `json:"state"`    // Stan gry (State...)
+	Word              string             `json:"word,omitempty"`
+	Triggers          []AttributeTrigger `json:"triggers,omitempty"` // Atrybuty, które zadziałały w ostatnim ruchu
+}
+
+// View zwraca aktualny widok gry
+func (g *Game) View() GameView {
+	view := GameView{
+		Pattern:           g.GetWordWithGuesses(),
+		Category:          g.Category,
+		Language:          g.Language,
+		WrongGuesses:      g.GetWrongGuesses(),
+		WrongWords:        append([]string(nil), g.WrongWords...),
+		ForgivenGuesses:   g.GetForgivenGuesses(),
+		UsedAttempts:      g.UsedAttempts(),
+		RemainingAttempts: g.GetRemainingAttempts(),
+		MaxAttempts:       g.MaxAttempts,
+		Points:            g.Points,
+		Progress:          g.GetProgress(),
+		State:             g.State.String(),
+		Triggers:          append([]AttributeTrigger(nil), g.Triggers...),
+	}
+
+	if g.HintRevealed {
+		view.Hint = g.Hint
+	}
+	if g.State != Playing {
+		view.Word = g.Word
+	}
+
+	return view
+}
@@ -0,0 +1,103 @@
+package game
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Rodzaje problemów ze słowami w paczce
+const (
+	IssueDuplicate   = "duplicate"    // Duplikat (po normalizacji)
+	IssueInvalidChar = "invalid_char" // Znak niebędący literą
+	IssueTooShort    = "too_short"    // Za mało liter
+	IssueTooLong     = "too_long"     // Za dużo liter
+	IssueWhitespace  = "whitespace"   // Nadmiarowe białe znaki
+	IssueCase        = "case"         // Wielkie litery
+)
+
+// WordIssue opisuje problem ze słowem w paczce
+type WordIssue struct {
+	Line   int    // Numer linii w pliku (0 jeśli nieznany)
+	Word   string // Słowo, którego dotyczy problem
+	Kind   string // Rodzaj problemu (Issue...)
+	Detail string // Opis problemu
+}
+
+// WordFilter określa, jakie słowa mogą trafić do paczki
+type WordFilter struct {
+	MinLength int      // Minimalna liczba liter
+	MaxLength int      // Maksymalna liczba liter (0 = bez ograniczenia)
+	Alphabet  Alphabet // Alfabet, z którego mogą pochodzić litery
+}
+
+// DefaultWordFilter zwraca domyślne ograniczenia dla słów w podanym języku
+func DefaultWordFilter(language string) WordFilter {
+	return WordFilter{MinLength: 3, MaxLength: 30, Alphabet: GetAlphabet(language)}
+}
+
+// isWordSeparator sprawdza czy znak może rozdzielać litery w słowie lub frazie
+func isWordSeparator(r rune) bool {
+	return r == ' ' || r == '-' || r == '\''
+}
+
+// Check sprawdza znormalizowane słowo i zwraca znalezione problemy
+func (f WordFilter) Check(word string) []WordIssue {
+	var issues []WordIssue
+
+	var invalid []string
+	for _, char := range word {
+		if !f.Alphabet.IsLetter(char) && !isWordSeparator(char) {
+			invalid = append(invalid, fmt.Sprintf("%q", char))
+		}
+	}
+	if len(invalid) > 0 {
+		issues = append(issues, WordIssue{Word: word, Kind: IssueInvalidChar,
+			Detail: "niedozwolone znaki: " + strings.Join(invalid, ", ")})
+	}
+
+	length := f.Alphabet.CountLetters(word)
+	if length < f.MinLength {
+		issues = append(issues, WordIssue{Word: word, Kind: IssueTooShort,
+			Detail: fmt.Sprintf("%d liter (minimum %d)", length, f.MinLength)})
+	}
+	if f.MaxLength > 0 && length > f.MaxLength {
+		issues = append(issues, WordIssue{Word: word, Kind: IssueTooLong,
+			Detail: fmt.Sprintf("%d liter (maksimum %d)", length, f.MaxLength)})
+	}
+
+	return issues
+}
+
+// LintWords sprawdza słowa odczytane z pliku i zwraca wszystkie znalezione problemy
+func LintWords(words []RawWord, filter WordFilter) []WordIssue {
+	var issues []WordIssue
+	firstLine := make(map[string]int)
+
+	for _, raw := range words {
+		cleaned := CleanWordText(raw.Raw)
+
+		if raw.Raw != strings.Join(strings.Fields(raw.Raw), " ") {
+			issues = append(issues, WordIssue{Line: raw.Line, Word: raw.Raw, Kind: IssueWhitespace,
+				Detail: "nadmiarowe białe znaki"})
+		}
+		if strings.TrimSpace(raw.Raw) != strings.ToLower(strings.TrimSpace(raw.Raw)) {
+			issues = append(issues, WordIssue{Line: raw.Line, Word: raw.Raw, Kind: IssueCase,
+				Detail: "wielkie litery"})
+		}
+
+		for _, issue := range filter.Check(cleaned) {
+			issue.Line = raw.Line
+			issues = append(issues, issue)
+		}
+
+		key := filter.Alphabet.FoldWord(cleaned)
+		if line, ok := firstLine[key]; ok {
+			issues = append(issues, WordIssue{Line: raw.Line, Word: cleaned, Kind: IssueDuplicate,
+				Detail: fmt.Sprintf("duplikat słowa z linii %d", line)})
+		} else {
+			firstLine[key] = raw.Line
+		}
+	}
+
+	return issues
+}
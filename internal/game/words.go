@@ -2,90 +2,528 @@ package game
 
 import (
 	"bufio"
+	"fmt"
+	"math"
 	"math/rand"
 	"os"
+	"path/filepath"
+	"sort"
 	"strings"
 	"time"
 	"unicode"
 )
 
-// WordsManager zarządza słowami do gry
+// Word reprezentuje słowo do odgadnięcia wraz z metadanymi
+type Word struct {
+	Text     string   // Słowo lub fraza
+	Category string   // Kategoria słowa (opcjonalna)
+	Hint     string   // Podpowiedź lub definicja (opcjonalna)
+	Language string   // Kod języka słowa (opcjonalny)
+	Tags     []string // Dodatkowe znaczniki
+	Score    float64  // Trudność słowa (0-100) obliczona przy wczytywaniu
+}
+
+// WordBand reprezentuje zakres trudności słów
+type WordBand struct {
+	Min float64 // Minimalna trudność słowa
+	Max float64 // Maksymalna trudność słowa (0 = bez ograniczenia)
+}
+
+// Contains sprawdza czy trudność słowa mieści się w zakresie
+func (b WordBand) Contains(score float64) bool {
+	return score >= b.Min && (b.Max <= 0 || score <= b.Max)
+}
+
+// Distance zwraca odległość trudności słowa od zakresu (0 jeśli mieści się w zakresie)
+func (b WordBand) Distance(score float64) float64 {
+	if score < b.Min {
+		return b.Min - score
+	}
+	if b.Max > 0 && score > b.Max {
+		return score - b.Max
+	}
+	return 0
+}
+
+// WordsManager zarządza słowami do gry (z osobną paczką słów dla każdego języka)
 type WordsManager struct {
-	words []string
+	words    []Word            // Aktywna paczka słów
+	language string            // Kod języka aktywnej paczki
+	packs    map[string][]Word // Paczki słów według kodu języka
+	bag      *ShuffleBag       // Worek słów jeszcze niewylosowanych
+	recent   map[string]bool   // Ostatnio rozegrane słowa (znormalizowane) do pominięcia
+	rng      *rand.Rand        // Generator losowania słów i ziaren gier
 }
 
-// NewWordsManager tworzy nowy manager słów
+// NewWordsManager tworzy nowy manager słów z jednego pliku
 func NewWordsManager(filePath string) (*WordsManager, error) {
+	words, err := LoadWordsFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewWordsManagerFromWords(words, ""), nil
+}
+
+// NewWordsManagerFromWords tworzy manager słów z jedną, już wczytaną paczką słów
+func NewWordsManagerFromWords(words []Word, language string) *WordsManager {
+	return &WordsManager{
+		words:    words,
+		language: language,
+		packs:    map[string][]Word{language: words},
+		bag:      NewShuffleBag(),
+		rng:      newTimeRand(),
+	}
+}
+
+// NewWordsManagerFromDir tworzy manager słów z katalogu paczek językowych
+// (np. pl.tsv, en.tsv) i aktywuje paczkę dla podanego języka
+func NewWordsManagerFromDir(dirPath string, language string) (*WordsManager, error) {
+	entries, err := os.ReadDir(dirPath)
+	if err != nil {
+		return nil, err
+	}
+
+	wm := &WordsManager{packs: make(map[string][]Word), bag: NewShuffleBag(), rng: newTimeRand()}
+	for _, entry := range entries {
+		ext := strings.ToLower(filepath.Ext(entry.Name()))
+		if entry.IsDir() || (ext != ".tsv" && ext != ".txt") {
+			continue
+		}
+
+		code := PackLanguage(entry.Name())
+		words, err := LoadWordsFile(filepath.Join(dirPath, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		// Słowa bez własnego języka należą do języka paczki
+		for i := range words {
+			if words[i].Language == "" {
+				words[i].Language = code
+			}
+		}
+		wm.packs[code] = words
+	}
+
+	if len(wm.packs) == 0 {
+		return nil, fmt.Errorf("brak paczek słów w katalogu %s", dirPath)
+	}
+
+	if !wm.SetLanguage(language) {
+		wm.SetLanguage(wm.GetLanguages()[0])
+	}
+
+	return wm, nil
+}
+
+// RawWord reprezentuje słowo odczytane z pliku przed normalizacją
+type RawWord struct {
+	Line int    // Numer linii w pliku
+	Raw  string // Słowo dokładnie tak, jak zapisano je w pliku
+	Word Word   // Słowo wraz z metadanymi
+}
+
+// IsTSVWordsFile sprawdza czy plik słów jest w formacie TSV
+func IsTSVWordsFile(filePath string) bool {
+	return strings.EqualFold(filepath.Ext(filePath), ".tsv")
+}
+
+// ReadRawWords odczytuje słowa z pliku bez normalizacji i usuwania duplikatów
+// Pliki .tsv zawierają w kolejnych kolumnach: słowo, kategorię, podpowiedź, język i tagi (oddzielone przecinkami);
+// pozostałe pliki zawierają jedno słowo w każdej linii. Puste linie i linie zaczynające się od # są pomijane.
+func ReadRawWords(filePath string) ([]RawWord, error) {
 	file, err := os.Open(filePath)
 	if err != nil {
 		return nil, err
 	}
 	defer file.Close()
 
-	var words []string
+	isTSV := IsTSVWordsFile(filePath)
+
+	var words []RawWord
+	lineNumber := 0
 	scanner := bufio.NewScanner(file)
 	for scanner.Scan() {
-		word := scanner.Text()
-		if word != "" {
-			words = append(words, strings.ToLower(strings.TrimSpace(word)))
+		lineNumber++
+		line := scanner.Text()
+		if strings.TrimSpace(line) == "" || strings.HasPrefix(strings.TrimSpace(line), "#") {
+			continue
+		}
+
+		raw := RawWord{Line: lineNumber, Raw: line}
+		if isTSV {
+			raw.Raw = strings.Split(line, "\t")[0]
+			raw.Word = parseTSVWord(line)
+		} else {
+			raw.Word = Word{Text: line}
 		}
+
+		words = append(words, raw)
 	}
 
 	if err := scanner.Err(); err != nil {
 		return nil, err
 	}
 
-	return &WordsManager{words: words}, nil
+	return words, nil
 }
 
-// GetRandomWord zwraca losowe słowo z listy
-func (wm *WordsManager) GetRandomWord() string {
-	rand.Seed(time.Now().UnixNano())
-	return wm.words[rand.Intn(len(wm.words))]
+// CleanWordText normalizuje zapis słowa: małe litery i pojedyncze spacje między wyrazami
+func CleanWordText(text string) string {
+	return strings.ToLower(strings.Join(strings.Fields(text), " "))
 }
 
-// ContainsPolishChars sprawdza czy słowo zawiera polskie znaki
-func ContainsPolishChars(word string) bool {
-	polishChars := []rune{'ą', 'ć', 'ę', 'ł', 'ń', 'ó', 'ś', 'ź', 'ż'}
+// PackLanguage zwraca kod języka paczki słów na podstawie nazwy pliku (np. "en" dla en.tsv)
+func PackLanguage(filePath string) string {
+	return strings.ToLower(strings.TrimSuffix(filepath.Base(filePath), filepath.Ext(filePath)))
+}
+
+// wordKey zwraca klucz słowa uproszczony alfabetem języka paczki
+// (słowa różniące się tylko znakami diakrytycznymi mają ten sam klucz)
+func wordKey(language string, word string) string {
+	return GetAlphabet(language).FoldWord(word)
+}
+
+// LoadWordsFile wczytuje słowa z pliku (znormalizowane, bez duplikatów)
+func LoadWordsFile(filePath string) ([]Word, error) {
+	rawWords, err := ReadRawWords(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	// Duplikaty są wykrywane alfabetem języka paczki (z nazwy pliku)
+	alphabet := GetAlphabet(PackLanguage(filePath))
+
+	var words []Word
+	seen := make(map[string]bool)
+	for _, raw := range rawWords {
+		word := raw.Word
+
+		// Zachowaj pojedyncze spacje wewnątrz fraz, usuń nadmiarowe białe znaki
+		word.Text = CleanWordText(word.Text)
+		if word.Text == "" {
+			continue
+		}
+
+		// Pomiń duplikaty (również różniące się tylko znakami diakrytycznymi)
+		key := alphabet.FoldWord(word.Text)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		word.Score = WordDifficultyScore(word.Text, alphabet)
+		words = append(words, word)
+	}
+
+	if len(words) == 0 {
+		return nil, fmt.Errorf("plik %s nie zawiera słów", filePath)
+	}
+
+	return words, nil
+}
+
+// SetLanguage przełącza aktywną paczkę słów na podany język
+func (wm *WordsManager) SetLanguage(language string) bool {
+	words, ok := wm.packs[language]
+	if !ok {
+		return false
+	}
+
+	wm.language = language
+	wm.words = words
+	return true
+}
+
+// GetLanguage zwraca kod języka aktywnej paczki słów
+func (wm *WordsManager) GetLanguage() string {
+	return wm.language
+}
+
+// GetLanguages zwraca posortowane kody języków dostępnych paczek słów
+func (wm *WordsManager) GetLanguages() []string {
+	languages := make([]string, 0, len(wm.packs))
+	for language := range wm.packs {
+		languages = append(languages, language)
+	}
+	sort.Strings(languages)
+	return languages
+}
+
+// parseTSVWord odczytuje słowo z linii w formacie TSV
+func parseTSVWord(line string) Word {
+	columns := strings.Split(line, "\t")
+	column := func(i int) string {
+		if i < len(columns) {
+			return strings.TrimSpace(columns[i])
+		}
+		return ""
+	}
+
+	word := Word{
+		Text:     column(0),
+		Category: strings.ToLower(column(1)),
+		Hint:     column(2),
+		Language: strings.ToLower(column(3)),
+	}
+
+	for _, tag := range strings.Split(column(4), ",") {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			word.Tags = append(word.Tags, tag)
+		}
+	}
+
+	return word
+}
+
+// WordDifficultyScore oblicza trudność słowa w skali 0-100 na podstawie
+// liczby liter, liczby różnych liter i rzadkości liter w języku alfabetu
+// (litery upraszczane do tej samej postaci, np. "a" i "ą", liczą się jako jedna)
+func WordDifficultyScore(word string, alphabet Alphabet) float64 {
+	letters := 0
+	distinct := make(map[string]rune)
 	for _, char := range word {
-		for _, polish := range polishChars {
-			if char == polish {
-				return true
-			}
+		if alphabet.IsLetter(char) {
+			letters++
+			distinct[alphabet.Fold(char)] = char
+		}
+	}
+
+	if letters == 0 {
+		return 0
+	}
+
+	rarity := 0.0
+	for _, letter := range distinct {
+		rarity += alphabet.LetterRarity(letter)
+	}
+	rarity /= float64(len(distinct))
+
+	lengthScore := math.Min(float64(letters)/15, 1)
+	distinctScore := math.Min(float64(len(distinct))/12, 1)
+
+	return math.Round((lengthScore*35+distinctScore*30+rarity*35)*10) / 10
+}
+
+// GetWords zwraca wszystkie wczytane słowa
+func (wm *WordsManager) GetWords() []Word {
+	return wm.words
+}
+
+// GetPackWords zwraca słowa paczki dla podanego języka (nil, gdy paczki nie ma)
+func (wm *WordsManager) GetPackWords(language string) []Word {
+	return wm.packs[language]
+}
+
+// FindWord szuka słowa w paczce podanego języka (bez względu na wielkość liter)
+func (wm *WordsManager) FindWord(language string, text string) (Word, bool) {
+	for _, word := range wm.packs[language] {
+		if strings.EqualFold(word.Text, text) {
+			return word, true
+		}
+	}
+	return Word{}, false
+}
+
+// GetCategories zwraca posortowaną listę kategorii słów
+func (wm *WordsManager) GetCategories() []string {
+	seen := make(map[string]bool)
+	var categories []string
+	for _, word := range wm.words {
+		if word.Category != "" && !seen[word.Category] {
+			seen[word.Category] = true
+			categories = append(categories, word.Category)
+		}
+	}
+	sort.Strings(categories)
+	return categories
+}
+
+// GetWordsByCategory zwraca słowa z podanej kategorii
+func (wm *WordsManager) GetWordsByCategory(category string) []Word {
+	var words []Word
+	for _, word := range wm.words {
+		if word.Category == strings.ToLower(category) {
+			words = append(words, word)
+		}
+	}
+	return words
+}
+
+// GetRandomWordFromCategory zwraca losowe słowo z podanej kategorii
+func (wm *WordsManager) GetRandomWordFromCategory(category string) (Word, bool) {
+	return wm.pickWord(func(w Word) bool {
+		return w.Category == strings.ToLower(category)
+	})
+}
+
+// SetShuffleBag ustawia worek słów (np. wczytany z poprzedniej sesji)
+func (wm *WordsManager) SetShuffleBag(bag *ShuffleBag) {
+	if bag == nil {
+		bag = NewShuffleBag()
+	}
+	wm.bag = bag
+}
+
+// GetShuffleBag zwraca worek słów
+func (wm *WordsManager) GetShuffleBag() *ShuffleBag {
+	return wm.bag
+}
+
+// SetRandSource ustawia źródło losowości (ten sam stan źródła daje tę samą sekwencję słów i ziaren gier)
+func (wm *WordsManager) SetRandSource(source rand.Source) {
+	wm.rng = rand.New(source)
+}
+
+// NewGameSeed losuje ziarno dla kolejnej gry
+func (wm *WordsManager) NewGameSeed() int64 {
+	return wm.rng.Int63()
+}
+
+// newTimeRand tworzy generator zainicjowany aktualnym czasem
+func newTimeRand() *rand.Rand {
+	return rand.New(rand.NewSource(time.Now().UnixNano()))
+}
+
+// SetRecentWords ustawia ostatnio rozegrane słowa, które nie powinny być losowane
+func (wm *WordsManager) SetRecentWords(words []string) {
+	wm.recent = make(map[string]bool)
+	for _, word := range words {
+		wm.recent[wordKey(wm.language, word)] = true
+	}
+}
+
+// GetRandomWord zwraca losowe słowo z listy
+func (wm *WordsManager) GetRandomWord() Word {
+	word, _ := wm.pickWord(func(w Word) bool { return true })
+	return word
+}
+
+// pickWord zwraca losowe słowo spełniające warunek
+// Słowa są losowane z worka (bez powtórzeń aż do wyczerpania paczki),
+// z pominięciem ostatnio rozegranych słów, o ile to możliwe
+func (wm *WordsManager) pickWord(matches func(Word) bool) (Word, bool) {
+	var matching []Word
+	for _, word := range wm.words {
+		if matches(word) {
+			matching = append(matching, word)
+		}
+	}
+
+	if len(matching) == 0 {
+		return Word{}, false
+	}
+
+	if !wm.bag.IsInitialized(wm.language) {
+		wm.bag.Refill(wm.language, wm.words)
+	}
+
+	inBag := wm.filterWords(matching, func(w Word) bool { return wm.bag.Contains(wm.language, w.Text) })
+	if len(inBag) == 0 {
+		// Wszystkie pasujące słowa zostały już wylosowane - włóż do worka tylko je,
+		// aby słowa z innych zakresów nie wracały przed przejściem przez całą paczkę
+		wm.bag.PutBack(wm.language, matching)
+		inBag = matching
+	}
+
+	candidates := wm.filterWords(inBag, func(w Word) bool { return !wm.recent[wordKey(wm.language, w.Text)] })
+	if len(candidates) == 0 {
+		candidates = inBag
+	}
+
+	word := candidates[wm.rng.Intn(len(candidates))]
+	wm.bag.Remove(wm.language, word.Text)
+
+	return word, true
+}
+
+// filterWords zwraca słowa spełniające warunek
+func (wm *WordsManager) filterWords(words []Word, keep func(Word) bool) []Word {
+	var result []Word
+	for _, word := range words {
+		if keep(word) {
+			result = append(result, word)
 		}
 	}
-	return false
+	return result
+}
+
+// closestWord zwraca losowe słowo spośród słów najbliższych zakresowi trudności
+func (wm *WordsManager) closestWord(band WordBand) Word {
+	closest := math.Inf(1)
+	for _, word := range wm.words {
+		closest = math.Min(closest, band.Distance(word.Score))
+	}
+
+	word, _ := wm.pickWord(func(w Word) bool {
+		return band.Distance(w.Score) == closest
+	})
+	return word
 }
 
-// NormalizeGuess normalizuje literę wprowadzoną przez użytkownika
+// GetRandomWordInRange zwraca losowe słowo o liczbie liter z podanego zakresu
+// (0 oznacza brak ograniczenia); jeśli żadne słowo nie pasuje, zwraca dowolne słowo
+func (wm *WordsManager) GetRandomWordInRange(minLength, maxLength int) Word {
+	if word, ok := wm.pickWord(func(w Word) bool {
+		return wm.inLengthRange(w.Text, minLength, maxLength)
+	}); ok {
+		return word
+	}
+	return wm.GetRandomWord()
+}
+
+// GetRandomWordInBand zwraca losowe słowo z zakresu trudności;
+// jeśli zakres jest pusty, zwraca słowo o trudności najbliższej zakresowi
+func (wm *WordsManager) GetRandomWordInBand(band WordBand) Word {
+	if word, ok := wm.pickWord(func(w Word) bool {
+		return band.Contains(w.Score)
+	}); ok {
+		return word
+	}
+	return wm.closestWord(band)
+}
+
+// GetRandomWordForDifficulty zwraca losowe słowo pasujące do poziomu trudności
+func (wm *WordsManager) GetRandomWordForDifficulty(difficulty Difficulty) Word {
+	band := difficulty.WordBand()
+
+	// Najpierw szukaj słów spełniających oba warunki, potem tylko zakres trudności
+	if word, ok := wm.pickWord(func(w Word) bool {
+		return band.Contains(w.Score) && wm.inLengthRange(w.Text, difficulty.MinWordLength, difficulty.MaxWordLength)
+	}); ok {
+		return word
+	}
+	return wm.GetRandomWordInBand(band)
+}
+
+// inLengthRange sprawdza czy liczba liter słowa (w alfabecie aktywnej paczki) mieści się w zakresie (0 = bez ograniczenia)
+func (wm *WordsManager) inLengthRange(word string, minLength, maxLength int) bool {
+	length := GetAlphabet(wm.language).CountLetters(word)
+	return length >= minLength && (maxLength <= 0 || length <= maxLength)
+}
+
+// ContainsPolishChars sprawdza czy słowo zawiera polskie znaki
+func ContainsPolishChars(word string) bool {
+	return PolishAlphabet().HasDiacritics(word)
+}
+
+// NormalizeGuess normalizuje literę wprowadzoną przez użytkownika według zasad języka polskiego
+// (litery upraszczane do kilku znaków pozostają bez zmian, np. ß)
 func NormalizeGuess(guess rune) rune {
-	normalized := unicode.ToLower(guess)
-
-	// Mapowanie polskich znaków na podstawowe
-	switch normalized {
-	case 'ą':
-		return 'a'
-	case 'ć':
-		return 'c'
-	case 'ę':
-		return 'e'
-	case 'ł':
-		return 'l'
-	case 'ń':
-		return 'n'
-	case 'ó':
-		return 'o'
-	case 'ś':
-		return 's'
-	case 'ź', 'ż':
-		return 'z'
-	default:
-		return normalized
-	}
-}
-
-// IsPolishLetter sprawdza czy znak jest polską literą
+	folded := []rune(PolishAlphabet().Fold(guess))
+	if len(folded) != 1 {
+		return unicode.ToLower(guess)
+	}
+	return folded[0]
+}
+
+// NormalizeWord normalizuje całe słowo (lub frazę) według zasad języka polskiego
+func NormalizeWord(word string) string {
+	return PolishAlphabet().FoldWord(word)
+}
+
+// IsPolishLetter sprawdza czy znak jest literą alfabetu polskiego
 func IsPolishLetter(r rune) bool {
-	return unicode.IsLetter(r) || r == 'ą' || r == 'ć' || r == 'ę' || r == 'ł' ||
-		r == 'ń' || r == 'ó' || r == 'ś' || r == 'ź' || r == 'ż'
+	return PolishAlphabet().IsLetter(r)
 }
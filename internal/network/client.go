@@ -0,0 +1,73 @@
+package network
+
+import (
+	"bufio"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+)
+
+// Client to połączenie gracza z serwerem gry
+type Client struct {
+	conn    net.Conn
+	scanner *bufio.Scanner
+	mu      sync.Mutex // Chroni zapis do połączenia
+}
+
+// Dial łączy się z serwerem gry pod podanym adresem
+func Dial(addr string) (*Client, error) {
+	conn, err := net.Dial("tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+
+	scanner := bufio.NewScanner(conn)
+	// Stan pokoju w JSON może być dłuższy niż domyślny limit linii
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	return &Client{conn: conn, scanner: scanner}, nil
+}
+
+// Send wysyła polecenie do serwera
+func (c *Client) Send(command string, args ...string) error {
+	line := strings.TrimSpace(command + " " + strings.Join(args, " "))
+	if strings.ContainsAny(line, "\r\n") {
+		return fmt.Errorf("polecenie nie może zawierać znaku nowej linii")
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	_, err := fmt.Fprintln(c.conn, line)
+	return err
+}
+
+// Join dołącza do pokoju jako podany gracz
+func (c *Client) Join(room string, name string) error {
+	if strings.ContainsAny(room, " \t") {
+		return fmt.Errorf("nazwa pokoju nie może zawierać spacji")
+	}
+	return c.Send(CmdJoin, room, name)
+}
+
+// Guess wysyła literę lub całe słowo
+func (c *Client) Guess(input string) error {
+	return c.Send(CmdGuess, input)
+}
+
+// Receive czeka na następną wiadomość od serwera
+func (c *Client) Receive() (Message, error) {
+	if !c.scanner.Scan() {
+		if err := c.scanner.Err(); err != nil {
+			return Message{}, err
+		}
+		return Message{}, net.ErrClosed
+	}
+	return ParseMessage(c.scanner.Text())
+}
+
+// Close kończy połączenie z serwerem
+func (c *Client) Close() error {
+	c.Send(CmdQuit)
+	return c.conn.Close()
+}
@@ -0,0 +1,81 @@
+package network
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/r3per/hanged-game/internal/game"
+)
+
+// Polecenia wysyłane przez klienta (jedno polecenie w linii: "POLECENIE argumenty")
+const (
+	CmdJoin  = "JOIN"  // JOIN <pokój> <gracz> - dołącza do pokoju (tworzy go, jeśli nie istnieje)
+	CmdGuess = "GUESS" // GUESS <litera lub słowo> - ruch gracza, którego jest kolej (w wyścigu - każdego gracza w dowolnej chwili)
+	CmdHint  = "HINT"  // HINT - odkrywa podpowiedź za punkty (w kolejce gracza)
+	CmdNew   = "NEW"   // NEW - rozpoczyna nową grę w pokoju po zakończeniu poprzedniej
+	CmdRace  = "RACE"  // RACE - rozpoczyna wyścig wszystkich graczy w pokoju (każdy ma własną grę z tym samym słowem)
+	CmdState = "STATE" // STATE - prosi o aktualny stan pokoju
+	CmdQuit  = "QUIT"  // QUIT - kończy połączenie
+)
+
+// Wiadomości wysyłane przez serwer (jedna wiadomość w linii: "TYP treść")
+const (
+	MsgWelcome = "WELCOME" // Powitanie po połączeniu
+	MsgState   = "STATE"   // Stan pokoju w formacie JSON (RoomState)
+	MsgInfo    = "INFO"    // Informacja dla graczy (np. ktoś dołączył)
+	MsgError   = "ERR"     // Błąd polecenia
+)
+
+// RoomState opisuje stan pokoju wysyłany do graczy
+type RoomState struct {
+	Room    string        `json:"room"`           // Nazwa pokoju
+	Players []string      `json:"players"`        // Gracze w kolejności ruchów
+	Turn    string        `json:"turn"`           // Gracz, którego jest kolej (pusty w trakcie wyścigu)
+	Game    game.GameView `json:"game"`           // Widok gry (w wyścigu: gra odbiorcy lub prowadzącego gracza)
+	Race    *RaceState    `json:"race,omitempty"` // Stan wyścigu (tylko w trybie wyścigu)
+}
+
+// RaceState opisuje wyścig w pokoju
+type RaceState struct {
+	Over      bool                `json:"over"`      // Czy wyścig się zakończył
+	Standings []game.RaceStanding `json:"standings"` // Aktualna klasyfikacja
+}
+
+// Message reprezentuje wiadomość od serwera
+type Message struct {
+	Type  string     // Typ wiadomości (Msg...)
+	Text  string     // Treść wiadomości
+	State *RoomState // Stan pokoju (tylko dla MsgState)
+}
+
+// splitLine dzieli linię protokołu na polecenie (lub typ) i resztę
+func splitLine(line string) (string, string) {
+	line = strings.TrimSpace(line)
+	name, rest, _ := strings.Cut(line, " ")
+	return strings.ToUpper(name), strings.TrimSpace(rest)
+}
+
+// ParseMessage odczytuje wiadomość serwera z linii protokołu
+func ParseMessage(line string) (Message, error) {
+	kind, text := splitLine(line)
+	message := Message{Type: kind, Text: text}
+
+	if kind == MsgState {
+		var state RoomState
+		if err := json.Unmarshal([]byte(text), &state); err != nil {
+			return Message{}, err
+		}
+		message.State = &state
+	}
+
+	return message, nil
+}
+
+// formatState zapisuje stan pokoju jako linię protokołu
+func formatState(state RoomState) string {
+	data, err := json.Marshal(state)
+	if err != nil {
+		return MsgError + " " + err.Error()
+	}
+	return MsgState + " " + string(data)
+}
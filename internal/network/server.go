@@ -0,0 +1,643 @@
+package network
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/r3per/hanged-game/internal/game"
+)
+
+// Rozmiar kolejki wiadomości wychodzących do jednego gracza
+const outgoingQueueSize = 64
+
+// GameFactory tworzy nową grę dla pokoju
+// Serwer wywołuje ją pod własną blokadą, więc nie musi ona być bezpieczna współbieżnie
+type GameFactory func() *game.Game
+
+// RaceRecorder zapisuje wynik zakończonego wyścigu
+// Jest wywoływana pod blokadą pokoju (gdy gracz opuszcza wyścig - także pod blokadą serwera),
+// więc nie powinna czekać na wolne operacje, np. zapis pliku; różne pokoje mogą wywoływać ją jednocześnie
+type RaceRecorder func(room string, race *game.Race)
+
+// Server to serwer TCP z pokojami, w których gracze na zmianę odgadują słowo
+type Server struct {
+	mu       sync.Mutex
+	rooms    map[string]*Room
+	newGame  GameFactory
+	onRace   RaceRecorder
+	listener net.Listener
+	conns    map[net.Conn]bool
+	closed   bool
+	wg       sync.WaitGroup
+}
+
+// NewServer tworzy serwer, który tworzy gry podaną funkcją
+func NewServer(newGame GameFactory) *Server {
+	return &Server{
+		rooms:   make(map[string]*Room),
+		conns:   make(map[net.Conn]bool),
+		newGame: newGame,
+	}
+}
+
+// Listen zaczyna nasłuchiwać pod podanym adresem (np. ":7777" lub "127.0.0.1:0")
+func (s *Server) Listen(addr string) error {
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	s.listener = listener
+	s.mu.Unlock()
+	return nil
+}
+
+// Addr zwraca adres, pod którym serwer nasłuchuje
+func (s *Server) Addr() net.Addr {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.listener == nil {
+		return nil
+	}
+	return s.listener.Addr()
+}
+
+// Serve przyjmuje połączenia aż do zamknięcia serwera
+func (s *Server) Serve() error {
+	s.mu.Lock()
+	listener := s.listener
+	s.mu.Unlock()
+	if listener == nil {
+		return errors.New("serwer nie nasłuchuje")
+	}
+
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			s.mu.Lock()
+			closed := s.closed
+			s.mu.Unlock()
+			if closed {
+				return nil
+			}
+			return err
+		}
+
+		s.mu.Lock()
+		if s.closed {
+			s.mu.Unlock()
+			conn.Close()
+			return nil
+		}
+		s.conns[conn] = true
+		s.wg.Add(1)
+		s.mu.Unlock()
+
+		go s.handleConn(conn)
+	}
+}
+
+// ListenAndServe nasłuchuje pod podanym adresem i przyjmuje połączenia
+func (s *Server) ListenAndServe(addr string) error {
+	if err := s.Listen(addr); err != nil {
+		return err
+	}
+	return s.Serve()
+}
+
+// Close zamyka serwer i wszystkie połączenia, a potem czeka na zakończenie ich obsługi
+func (s *Server) Close() error {
+	s.mu.Lock()
+	s.closed = true
+	var err error
+	if s.listener != nil {
+		err = s.listener.Close()
+	}
+	for conn := range s.conns {
+		conn.Close()
+	}
+	s.mu.Unlock()
+
+	s.wg.Wait()
+	return err
+}
+
+// SetRaceRecorder ustawia funkcję zapisującą wyniki wyścigów (dotyczy nowych pokoi)
+func (s *Server) SetRaceRecorder(record RaceRecorder) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.onRace = record
+}
+
+// RoomNames zwraca nazwy istniejących pokoi
+func (s *Server) RoomNames() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	names := make([]string, 0, len(s.rooms))
+	for name := range s.rooms {
+		names = append(names, name)
+	}
+	return names
+}
+
+// createGame tworzy nową grę pod blokadą serwera
+func (s *Server) createGame() *game.Game {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.newGame()
+}
+
+// join dodaje gracza do pokoju (tworząc pokój, jeśli nie istnieje)
+// Blokada serwera jest trzymana do końca, aby pokój nie został usunięty w trakcie dołączania
+func (s *Server) join(roomName string, p *player) (*Room, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	room, ok := s.rooms[roomName]
+	if !ok {
+		room = newRoom(roomName, s.newGame(), s.onRace)
+		s.rooms[roomName] = room
+	}
+
+	if err := room.add(p); err != nil {
+		if !ok {
+			delete(s.rooms, roomName)
+		}
+		return nil, err
+	}
+	return room, nil
+}
+
+// leave usuwa gracza z pokoju i usuwa pusty pokój
+func (s *Server) leave(room *Room, p *player) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if room.remove(p) == 0 && s.rooms[room.name] == room {
+		delete(s.rooms, room.name)
+	}
+}
+
+// handleConn obsługuje połączenie jednego gracza
+func (s *Server) handleConn(conn net.Conn) {
+	p := newPlayer(conn)
+	var room *Room
+
+	defer func() {
+		if room != nil {
+			s.leave(room, p)
+		}
+		p.close()
+		conn.Close()
+
+		s.mu.Lock()
+		delete(s.conns, conn)
+		s.mu.Unlock()
+		s.wg.Done()
+	}()
+
+	p.send(MsgWelcome + " Wisielec - dołącz do pokoju poleceniem: " + CmdJoin + " <pokój> <gracz>")
+
+	scanner := bufio.NewScanner(conn)
+	for scanner.Scan() {
+		command, arg := splitLine(scanner.Text())
+
+		if command == CmdQuit {
+			return
+		}
+
+		if command == CmdJoin {
+			if room != nil {
+				p.send(MsgError + " Jesteś już w pokoju " + room.name)
+				continue
+			}
+
+			roomName, name, _ := strings.Cut(arg, " ")
+			name = strings.TrimSpace(name)
+			if roomName == "" || name == "" {
+				p.send(MsgError + " Użycie: " + CmdJoin + " <pokój> <gracz>")
+				continue
+			}
+
+			p.name = name
+			joined, err := s.join(roomName, p)
+			if err != nil {
+				p.send(MsgError + " " + err.Error())
+				continue
+			}
+			room = joined
+			continue
+		}
+
+		if room == nil {
+			p.send(MsgError + " Najpierw dołącz do pokoju: " + CmdJoin + " <pokój> <gracz>")
+			continue
+		}
+
+		var err error
+		switch command {
+		case CmdGuess:
+			err = room.guess(p, arg)
+		case CmdHint:
+			err = room.hint(p)
+		case CmdNew:
+			err = room.restart(s.createGame)
+		case CmdRace:
+			err = room.startRace(s.createGame)
+		case CmdState:
+			room.sendState(p)
+		default:
+			err = fmt.Errorf("nieznane polecenie: %s", command)
+		}
+
+		if err != nil {
+			p.send(MsgError + " " + err.Error())
+		}
+	}
+}
+
+// Room to pokój z jedną grą, w której gracze odgadują litery na zmianę,
+// albo z wyścigiem, w którym każdy gracz odgaduje to samo słowo we własnej grze
+type Room struct {
+	mu      sync.Mutex
+	name    string
+	game    *game.Game
+	race    *game.Race // Wyścig (nil, gdy gracze grają na zmianę)
+	onRace  RaceRecorder
+	players []*player
+	turn    int // Indeks gracza, którego jest kolej
+}
+
+// newRoom tworzy pokój z grą
+func newRoom(name string, g *game.Game, onRace RaceRecorder) *Room {
+	return &Room{name: name, game: g, onRace: onRace}
+}
+
+// add dodaje gracza na koniec kolejki
+func (r *Room) add(p *player) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, other := range r.players {
+		if other.name == p.name {
+			return fmt.Errorf("gracz %s jest już w pokoju %s", p.name, r.name)
+		}
+	}
+
+	r.players = append(r.players, p)
+	r.broadcastLocked(MsgInfo + " " + p.name + " dołącza do pokoju " + r.name)
+	r.broadcastStateLocked()
+	return nil
+}
+
+// remove usuwa gracza z pokoju i zwraca liczbę pozostałych graczy
+func (r *Room) remove(p *player) int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i, other := range r.players {
+		if other != p {
+			continue
+		}
+
+		r.players = append(r.players[:i], r.players[i+1:]...)
+		// Kolejka przesuwa się o odchodzącego gracza; jeśli odszedł gracz, którego była kolej, ruch ma następny
+		if i < r.turn {
+			r.turn--
+		}
+		if r.turn >= len(r.players) {
+			r.turn = 0
+		}
+
+		if len(r.players) > 0 {
+			r.broadcastLocked(MsgInfo + " " + p.name + " opuszcza pokój")
+			// Gracz, który opuścił wyścig, przegrywa swoją grę
+			if r.race != nil && !r.race.Over() {
+				r.race.Forfeit(r.race.PlayerIndex(p.name))
+				r.finishRaceLocked()
+			}
+			r.broadcastStateLocked()
+		}
+		break
+	}
+
+	return len(r.players)
+}
+
+// guess wykonuje ruch gracza (literę lub całe słowo), jeśli jest jego kolej
+func (r *Room) guess(p *player, input string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.race != nil {
+		return r.raceGuessLocked(p, input)
+	}
+
+	if err := r.checkTurnLocked(p); err != nil {
+		return err
+	}
+
+	input = strings.TrimSpace(input)
+	accepted := false
+	if utf8.RuneCountInString(input) == 1 {
+		letter, _ := utf8.DecodeRuneInString(input)
+		accepted = r.game.Guess(letter)
+	} else if input != "" {
+		accepted = r.game.GuessWord(input)
+	}
+
+	if !accepted {
+		return fmt.Errorf("nieprawidłowy lub powtórzony ruch: %q", input)
+	}
+
+	r.turn = (r.turn + 1) % len(r.players)
+	r.broadcastStateLocked()
+	return nil
+}
+
+// hint odkrywa podpowiedź za punkty (zajmuje ruch gracza)
+func (r *Room) hint(p *player) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	g := r.game
+	if r.race != nil {
+		player, err := r.racePlayerLocked(p)
+		if err != nil {
+			return err
+		}
+		g = player.Game
+	} else if err := r.checkTurnLocked(p); err != nil {
+		return err
+	}
+	if !g.RevealHint(game.HintCost) {
+		return errors.New("podpowiedź jest niedostępna")
+	}
+
+	// Podpowiedź nie kończy kolejki - gracz nadal musi wykonać ruch
+	r.broadcastStateLocked()
+	return nil
+}
+
+// restart rozpoczyna nową grę, jeśli poprzednia już się zakończyła
+func (r *Room) restart(newGame func() *game.Game) error {
+	r.mu.Lock()
+	finished := !r.inProgressLocked()
+	r.mu.Unlock()
+	if !finished {
+		return errors.New("gra w pokoju jeszcze trwa")
+	}
+
+	// Grę tworzymy bez blokady pokoju, aby nie blokować pokoju i serwera jednocześnie
+	g := newGame()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.inProgressLocked() {
+		return errors.New("nowa gra została już rozpoczęta")
+	}
+
+	r.game = g
+	r.race = nil
+	r.broadcastLocked(MsgInfo + " Nowa gra w pokoju " + r.name)
+	r.broadcastStateLocked()
+	return nil
+}
+
+// startRace rozpoczyna wyścig wszystkich graczy w pokoju z nowym słowem
+// (gdy nie trwa inna gra lub nikt jeszcze nie wykonał ruchu)
+func (r *Room) startRace(newGame func() *game.Game) error {
+	r.mu.Lock()
+	err := r.checkRaceStartLocked()
+	r.mu.Unlock()
+	if err != nil {
+		return err
+	}
+
+	// Grę wzorcową tworzymy bez blokady pokoju, tak jak przy nowej grze
+	template := newGame()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if err := r.checkRaceStartLocked(); err != nil {
+		return err
+	}
+
+	names := make([]string, len(r.players))
+	for i, p := range r.players {
+		names[i] = p.name
+	}
+
+	r.game = template
+	r.race = game.NewRace(template, names)
+	r.broadcastLocked(MsgInfo + " Wyścig! Pierwszy, kto odgadnie słowo, wygrywa")
+	r.broadcastStateLocked()
+	return nil
+}
+
+// checkRaceStartLocked sprawdza czy w pokoju można rozpocząć wyścig
+func (r *Room) checkRaceStartLocked() error {
+	if r.race != nil && !r.race.Over() {
+		return errors.New("wyścig już trwa")
+	}
+	if r.race == nil && r.game.State == game.Playing && len(r.game.Moves) > 0 {
+		return errors.New("gra w pokoju jeszcze trwa")
+	}
+	if len(r.players) < 2 {
+		return errors.New("do wyścigu potrzeba co najmniej 2 graczy")
+	}
+	return nil
+}
+
+// raceGuessLocked wykonuje ruch gracza w jego grze wyścigowej
+func (r *Room) raceGuessLocked(p *player, input string) error {
+	player, err := r.racePlayerLocked(p)
+	if err != nil {
+		return err
+	}
+
+	if !r.race.Guess(r.race.PlayerIndex(p.name), input) {
+		if player.Game.State != game.Playing {
+			return errors.New("nie masz już ruchów - poczekaj na koniec wyścigu")
+		}
+		return fmt.Errorf("nieprawidłowy lub powtórzony ruch: %q", strings.TrimSpace(input))
+	}
+
+	r.finishRaceLocked()
+	r.broadcastStateLocked()
+	return nil
+}
+
+// racePlayerLocked zwraca grę gracza w trwającym wyścigu
+func (r *Room) racePlayerLocked(p *player) (*game.RacePlayer, error) {
+	if r.race.Over() {
+		return nil, errors.New("wyścig się zakończył - rozpocznij nową grę poleceniem " + CmdNew + " lub " + CmdRace)
+	}
+	index := r.race.PlayerIndex(p.name)
+	if index < 0 {
+		return nil, errors.New("nie bierzesz udziału w tym wyścigu")
+	}
+	return r.race.Players[index], nil
+}
+
+// finishRaceLocked ogłasza i zapisuje wynik wyścigu, jeśli właśnie się zakończył
+func (r *Room) finishRaceLocked() {
+	if !r.race.Over() {
+		return
+	}
+
+	if winner := r.race.Winner(); winner != nil {
+		r.broadcastLocked(MsgInfo + " " + winner.Name + " wygrywa wyścig!")
+	} else {
+		r.broadcastLocked(MsgInfo + " Nikt nie odgadł słowa")
+	}
+	if r.onRace != nil {
+		r.onRace(r.name, r.race)
+	}
+}
+
+// inProgressLocked sprawdza czy w pokoju trwa gra lub wyścig
+func (r *Room) inProgressLocked() bool {
+	if r.race != nil {
+		return !r.race.Over()
+	}
+	return r.game.State == game.Playing
+}
+
+// sendState wysyła stan pokoju jednemu graczowi
+func (r *Room) sendState(p *player) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	p.send(formatState(r.stateLocked(p)))
+}
+
+// State zwraca aktualny stan pokoju (w wyścigu z grą prowadzącego gracza)
+func (r *Room) State() RoomState {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.stateLocked(nil)
+}
+
+// checkTurnLocked sprawdza czy gra trwa i czy jest kolej gracza
+func (r *Room) checkTurnLocked(p *player) error {
+	if r.game.State != game.Playing {
+		return errors.New("gra się zakończyła - rozpocznij nową poleceniem " + CmdNew)
+	}
+	if r.players[r.turn] != p {
+		return fmt.Errorf("teraz kolej gracza %s", r.players[r.turn].name)
+	}
+	return nil
+}
+
+// stateLocked zwraca stan pokoju widziany przez gracza (wymaga blokady pokoju)
+// W wyścigu gracz widzi swoją grę, a pozostali - grę prowadzącego gracza
+func (r *Room) stateLocked(viewer *player) RoomState {
+	state := RoomState{Room: r.name}
+	for _, p := range r.players {
+		state.Players = append(state.Players, p.name)
+	}
+
+	if r.race == nil {
+		state.Game = r.game.View()
+		if len(r.players) > 0 {
+			state.Turn = r.players[r.turn].name
+		}
+		return state
+	}
+
+	standings := r.race.Standings()
+	state.Race = &RaceState{Over: r.race.Over(), Standings: standings}
+
+	index := -1
+	if viewer != nil {
+		index = r.race.PlayerIndex(viewer.name)
+	}
+	if index < 0 {
+		index = r.race.PlayerIndex(standings[0].Name)
+	}
+	state.Game = r.race.Players[index].Game.View()
+	// Słowo poznają wszyscy dopiero po wyścigu (również gracze, którzy już przegrali)
+	state.Game.Word = ""
+	if state.Race.Over {
+		state.Game.Word = r.game.Word
+	}
+	return state
+}
+
+// broadcastStateLocked wysyła stan pokoju wszystkim graczom (wymaga blokady pokoju)
+func (r *Room) broadcastStateLocked() {
+	for _, p := range r.players {
+		p.send(formatState(r.stateLocked(p)))
+	}
+}
+
+// broadcastLocked wysyła linię wszystkim graczom w pokoju (wymaga blokady pokoju)
+func (r *Room) broadcastLocked(line string) {
+	for _, p := range r.players {
+		p.send(line)
+	}
+}
+
+// player reprezentuje połączonego gracza
+// Wiadomości trafiają do kolejki i są zapisywane przez osobną gorutynę,
+// aby wolny klient nie blokował pokoju
+type player struct {
+	name string
+	out  chan string
+	done chan struct{}
+	once sync.Once
+}
+
+// newPlayer tworzy gracza i uruchamia zapis wiadomości do połączenia
+func newPlayer(conn net.Conn) *player {
+	p := &player{
+		out:  make(chan string, outgoingQueueSize),
+		done: make(chan struct{}),
+	}
+
+	go func() {
+		writer := bufio.NewWriter(conn)
+		for {
+			select {
+			case line := <-p.out:
+				writer.WriteString(line + "\n")
+				if len(p.out) == 0 {
+					if err := writer.Flush(); err != nil {
+						p.close()
+						return
+					}
+				}
+			case <-p.done:
+				// Wyślij to, co zostało w kolejce
+				for len(p.out) > 0 {
+					writer.WriteString(<-p.out + "\n")
+				}
+				writer.Flush()
+				return
+			}
+		}
+	}()
+
+	return p
+}
+
+// send dodaje linię do kolejki wiadomości gracza (pomija ją, gdy kolejka jest pełna)
+func (p *player) send(line string) {
+	select {
+	case <-p.done:
+	case p.out <- line:
+	default:
+	}
+}
+
+// close kończy wysyłanie wiadomości do gracza
+func (p *player) close() {
+	p.once.Do(func() { close(p.done) })
+}
@@ -0,0 +1,285 @@
+package network
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/r3per/hanged-game/internal/game"
+)
+
+// Czas oczekiwania na wiadomość od serwera w testach
+const testTimeout = 5 * time.Second
+
+// newTestGame tworzy grę ze stałym słowem
+func newTestGame() *game.Game {
+	word := game.Word{Text: "kot", Language: "pl"}
+	g := game.NewGameFromWord(word, game.Difficulty{ID: "medium", Attempts: 6}, game.GameModifiers{}, nil)
+	g.SetSeed(1)
+	return g
+}
+
+// startTestServer uruchamia serwer na wolnym porcie i zamyka go po teście
+func startTestServer(t *testing.T) *Server {
+	t.Helper()
+
+	server := NewServer(newTestGame)
+	if err := server.Listen("127.0.0.1:0"); err != nil {
+		t.Fatalf("Listen: %v", err)
+	}
+	go server.Serve()
+	t.Cleanup(func() { server.Close() })
+	return server
+}
+
+// dialTestClient łączy klienta z serwerem i czeka na powitanie
+func dialTestClient(t *testing.T, server *Server) *Client {
+	t.Helper()
+
+	client, err := Dial(server.Addr().String())
+	if err != nil {
+		t.Fatalf("Dial: %v", err)
+	}
+	t.Cleanup(func() { client.conn.Close() })
+
+	expectMessage(t, client, MsgWelcome, "")
+	return client
+}
+
+// joinTestRoom dołącza klienta do pokoju i czeka na stan pokoju z nowym graczem
+func joinTestRoom(t *testing.T, client *Client, room, name string) {
+	t.Helper()
+
+	if err := client.Join(room, name); err != nil {
+		t.Fatalf("Join: %v", err)
+	}
+	expectState(t, client, func(state *RoomState) bool {
+		return containsPlayer(state.Players, name)
+	})
+}
+
+// send wysyła polecenie i przerywa test w razie błędu
+func send(t *testing.T, client *Client, command string, args ...string) {
+	t.Helper()
+
+	if err := client.Send(command, args...); err != nil {
+		t.Fatalf("Send %s: %v", command, err)
+	}
+}
+
+// expectMessage czeka na wiadomość podanego typu zawierającą tekst (pomija pozostałe)
+func expectMessage(t *testing.T, client *Client, kind, text string) Message {
+	t.Helper()
+
+	client.conn.SetReadDeadline(time.Now().Add(testTimeout))
+	for {
+		message, err := client.Receive()
+		if err != nil {
+			t.Fatalf("oczekiwano wiadomości %s %q: %v", kind, text, err)
+		}
+		if message.Type == kind && strings.Contains(message.Text, text) {
+			return message
+		}
+	}
+}
+
+// expectState czeka na stan pokoju spełniający warunek (pomija pozostałe wiadomości)
+func expectState(t *testing.T, client *Client, match func(state *RoomState) bool) *RoomState {
+	t.Helper()
+
+	client.conn.SetReadDeadline(time.Now().Add(testTimeout))
+	for {
+		message, err := client.Receive()
+		if err != nil {
+			t.Fatalf("oczekiwano stanu pokoju: %v", err)
+		}
+		if message.Type == MsgState && match(message.State) {
+			return message.State
+		}
+	}
+}
+
+// containsPlayer sprawdza czy gracz jest na liście
+func containsPlayer(players []string, name string) bool {
+	for _, player := range players {
+		if player == name {
+			return true
+		}
+	}
+	return false
+}
+
+func TestJoin(t *testing.T) {
+	server := startTestServer(t)
+	ala := dialTestClient(t, server)
+	ola := dialTestClient(t, server)
+
+	joinTestRoom(t, ala, "pokoj", "ala")
+	joinTestRoom(t, ola, "pokoj", "ola")
+
+	state := expectState(t, ala, func(state *RoomState) bool { return len(state.Players) == 2 })
+	if state.Players[0] != "ala" || state.Players[1] != "ola" || state.Turn != "ala" {
+		t.Errorf("stan pokoju = %+v, oczekiwano graczy [ala ola] i kolei gracza ala", state)
+	}
+	if state.Game.Pattern != "_ _ _" || state.Game.Word != "" {
+		t.Errorf("widok gry = %+v, oczekiwano zakrytego słowa", state.Game)
+	}
+
+	other := dialTestClient(t, server)
+	if err := other.Join("pokoj", "ala"); err != nil {
+		t.Fatalf("Join: %v", err)
+	}
+	expectMessage(t, other, MsgError, "jest już w pokoju")
+
+	if names := server.RoomNames(); len(names) != 1 || names[0] != "pokoj" {
+		t.Errorf("RoomNames() = %v, oczekiwano [pokoj]", names)
+	}
+}
+
+func TestTurnEnforcement(t *testing.T) {
+	server := startTestServer(t)
+	ala := dialTestClient(t, server)
+	ola := dialTestClient(t, server)
+	joinTestRoom(t, ala, "pokoj", "ala")
+	joinTestRoom(t, ola, "pokoj", "ola")
+
+	send(t, ola, CmdGuess, "k")
+	expectMessage(t, ola, MsgError, "teraz kolej gracza ala")
+
+	send(t, ala, CmdGuess, "k")
+	state := expectState(t, ala, func(state *RoomState) bool { return state.Turn == "ola" })
+	if state.Game.Pattern != "k _ _" {
+		t.Errorf("Pattern = %q, oczekiwano %q", state.Game.Pattern, "k _ _")
+	}
+
+	send(t, ala, CmdGuess, "o")
+	expectMessage(t, ala, MsgError, "teraz kolej gracza ola")
+
+	send(t, ola, CmdGuess, "k")
+	expectMessage(t, ola, MsgError, "powtórzony")
+
+	send(t, ola, CmdGuess, "kot")
+	state = expectState(t, ola, func(state *RoomState) bool { return state.Game.State == game.StateWon })
+	if state.Game.Word != "kot" {
+		t.Errorf("Word = %q, oczekiwano %q po zakończeniu gry", state.Game.Word, "kot")
+	}
+
+	send(t, ala, CmdGuess, "a")
+	expectMessage(t, ala, MsgError, "gra się zakończyła")
+}
+
+func TestRestart(t *testing.T) {
+	server := startTestServer(t)
+	ala := dialTestClient(t, server)
+	joinTestRoom(t, ala, "pokoj", "ala")
+
+	send(t, ala, CmdNew)
+	expectMessage(t, ala, MsgError, "jeszcze trwa")
+
+	send(t, ala, CmdGuess, "kot")
+	expectState(t, ala, func(state *RoomState) bool { return state.Game.State == game.StateWon })
+
+	send(t, ala, CmdNew)
+	expectMessage(t, ala, MsgInfo, "Nowa gra")
+	state := expectState(t, ala, func(state *RoomState) bool { return true })
+	if state.Game.State != game.StatePlaying || state.Game.Pattern != "_ _ _" {
+		t.Errorf("widok gry = %+v, oczekiwano nowej gry", state.Game)
+	}
+}
+
+func TestRace(t *testing.T) {
+	server := startTestServer(t)
+	recorded := make(chan *game.Race, 1)
+	server.SetRaceRecorder(func(room string, race *game.Race) {
+		recorded <- race
+	})
+
+	ala := dialTestClient(t, server)
+	ola := dialTestClient(t, server)
+	joinTestRoom(t, ala, "pokoj", "ala")
+
+	send(t, ala, CmdRace)
+	expectMessage(t, ala, MsgError, "co najmniej 2 graczy")
+
+	joinTestRoom(t, ola, "pokoj", "ola")
+	send(t, ala, CmdRace)
+	expectState(t, ola, func(state *RoomState) bool { return state.Race != nil })
+
+	// W wyścigu każdy gra we własnym tempie, bez czekania na kolej
+	send(t, ola, CmdGuess, "o")
+	state := expectState(t, ola, func(state *RoomState) bool { return state.Game.Pattern == "_ o _" })
+	if state.Game.Word != "" {
+		t.Errorf("Word = %q, słowo nie powinno być widoczne w trakcie wyścigu", state.Game.Word)
+	}
+
+	send(t, ala, CmdGuess, "kot")
+	state = expectState(t, ola, func(state *RoomState) bool { return state.Race != nil && state.Race.Over })
+	if state.Game.Word != "kot" {
+		t.Errorf("Word = %q, oczekiwano %q po wyścigu", state.Game.Word, "kot")
+	}
+	if winner := state.Race.Standings[0]; winner.Name != "ala" || winner.State != game.StateWon {
+		t.Errorf("pierwsze miejsce = %+v, oczekiwano zwycięstwa gracza ala", winner)
+	}
+
+	select {
+	case race := <-recorded:
+		if race.Winner() == nil || race.Winner().Name != "ala" {
+			t.Errorf("zapisany wyścig bez zwycięzcy ala")
+		}
+	case <-time.After(testTimeout):
+		t.Fatal("wynik wyścigu nie został zapisany")
+	}
+
+	send(t, ola, CmdGuess, "t")
+	expectMessage(t, ola, MsgError, "wyścig się zakończył")
+}
+
+func TestLeaveForfeitsRace(t *testing.T) {
+	server := startTestServer(t)
+	recorded := make(chan *game.Race, 1)
+	server.SetRaceRecorder(func(room string, race *game.Race) {
+		recorded <- race
+	})
+
+	ala := dialTestClient(t, server)
+	ola := dialTestClient(t, server)
+	joinTestRoom(t, ala, "pokoj", "ala")
+	joinTestRoom(t, ola, "pokoj", "ola")
+
+	send(t, ala, CmdRace)
+	expectState(t, ola, func(state *RoomState) bool { return state.Race != nil })
+
+	send(t, ola, CmdQuit)
+	state := expectState(t, ala, func(state *RoomState) bool { return len(state.Players) == 1 })
+	if state.Race == nil || state.Race.Over {
+		t.Fatalf("stan wyścigu = %+v, wyścig powinien trwać dalej", state.Race)
+	}
+	for _, standing := range state.Race.Standings {
+		if standing.Name == "ola" && standing.State != game.StateLost {
+			t.Errorf("gracz ola ma stan %q, oczekiwano %q po opuszczeniu wyścigu", standing.State, game.StateLost)
+		}
+	}
+
+	send(t, ala, CmdGuess, "kot")
+	expectState(t, ala, func(state *RoomState) bool { return state.Race.Over })
+	select {
+	case <-recorded:
+	case <-time.After(testTimeout):
+		t.Fatal("wynik wyścigu nie został zapisany")
+	}
+}
+
+func TestLeaveRemovesEmptyRoom(t *testing.T) {
+	server := startTestServer(t)
+	ala := dialTestClient(t, server)
+	joinTestRoom(t, ala, "pokoj", "ala")
+
+	send(t, ala, CmdQuit)
+	deadline := time.Now().Add(testTimeout)
+	for len(server.RoomNames()) > 0 {
+		if time.Now().After(deadline) {
+			t.Fatalf("RoomNames() = %v, pusty pokój powinien zostać usunięty", server.RoomNames())
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
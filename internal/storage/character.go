@@ -0,0 +1,116 @@
+package storage
+
+import (
+	"encoding/json"
+	"os"
+
+	"github.com/r3per/hanged-game/internal/game"
+)
+
+// CharacterSave reprezentuje zapis postaci RPG
+type CharacterSave struct {
+	Level  *game.RPGLevel  `json:"level"`  // Poziom, doświadczenie, atrybuty i ekwipunek
+	Quests []game.RPGQuest `json:"quests"` // Postęp w zadaniach
+}
+
+// CharacterManager zarządza zapisem postaci RPG
+type CharacterManager struct {
+	save     CharacterSave
+	filePath string
+}
+
+// NewCharacterManager tworzy nowy manager postaci
+func NewCharacterManager(filePath string) (*CharacterManager, error) {
+	cm := &CharacterManager{
+		filePath: filePath,
+		save: CharacterSave{
+			Level:  game.NewRPGLevel(),
+			Quests: game.GenerateBasicQuests(),
+		},
+	}
+
+	// Spróbuj odczytać istniejący zapis postaci
+	_, err := os.Stat(filePath)
+	if err == nil {
+		err = cm.loadCharacter()
+		if err != nil {
+			return nil, err
+		}
+	} else if !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	return cm, nil
+}
+
+// loadCharacter wczytuje zapis postaci z pliku
+func (cm *CharacterManager) loadCharacter() error {
+	data, err := os.ReadFile(cm.filePath)
+	if err != nil {
+		return err
+	}
+
+	var save CharacterSave
+	err = json.Unmarshal(data, &save)
+	if err != nil {
+		return err
+	}
+
+	// Uzupełnij brakujące części zapisu wartościami domyślnymi
+	defaults := game.NewRPGLevel()
+	if save.Level == nil {
+		save.Level = defaults
+	}
+	if save.Level.Attributes == nil {
+		save.Level.Attributes = defaults.Attributes
+	}
+	if save.Level.Inventory == nil {
+		save.Level.Inventory = defaults.Inventory
+	}
+
+	cm.save.Level = save.Level
+	cm.save.Quests = mergeQuests(save.Quests, game.GenerateBasicQuests())
+
+	return nil
+}
+
+// mergeQuests dołącza do zapisanych zadań te, których zapis jeszcze nie zawiera
+func mergeQuests(saved []game.RPGQuest, available []game.RPGQuest) []game.RPGQuest {
+	known := make(map[string]bool)
+	for _, quest := range saved {
+		known[quest.ID] = true
+	}
+
+	for _, quest := range available {
+		if !known[quest.ID] {
+			saved = append(saved, quest)
+		}
+	}
+
+	return saved
+}
+
+// Save zapisuje postać do pliku
+func (cm *CharacterManager) Save() error {
+	data, err := json.MarshalIndent(cm.save, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(cm.filePath, data, 0644)
+}
+
+// GetLevel zwraca poziom postaci
+func (cm *CharacterManager) GetLevel() *game.RPGLevel {
+	return cm.save.Level
+}
+
+// GetQuests zwraca zadania postaci
+func (cm *CharacterManager) GetQuests() []game.RPGQuest {
+	return cm.save.Quests
+}
+
+// SetQuests ustawia zadania postaci
+func (cm *CharacterManager) SetQuests(quests []game.RPGQuest) {
+	cm.save.Quests = quests
+}
@@ -0,0 +1,129 @@
+package storage
+
+import (
+	"encoding/json"
+	"os"
+	"time"
+)
+
+// DefaultProfile to nazwa profilu używanego, gdy gracz nie wybrał własnego
+const DefaultProfile = "default"
+
+// DailyStarted to wynik zapisywany przed rozpoczęciem wyzwania dnia;
+// zostaje, jeśli gra została przerwana, więc wyzwania nie da się powtórzyć po wyjściu z gry
+const DailyStarted = "started"
+
+// DailyResult reprezentuje wynik wyzwania dnia
+type DailyResult struct {
+	Date         string    `json:"date"`   // Data wyzwania (RRRR-MM-DD)
+	Word         string    `json:"word"`   // Słowo dnia
+	Result       string    `json:"result"` // "win", "lose" lub DailyStarted
+	Points       int       `json:"points"`
+	DifficultyID string    `json:"difficulty_id,omitempty"` // Identyfikator poziomu trudności
+	Language     string    `json:"language,omitempty"`      // Kod języka słowa
+	Summary      string    `json:"summary"`                 // Podsumowanie do udostępnienia
+	PlayedAt     time.Time `json:"played_at"`
+}
+
+// DailyStats reprezentuje wyniki wyzwań dnia wszystkich profili
+type DailyStats struct {
+	Profiles map[string][]DailyResult `json:"profiles"`
+}
+
+// DailyManager zarządza wynikami wyzwań dnia (oddzielnie od zwykłych gier)
+type DailyManager struct {
+	stats    DailyStats
+	filePath string
+}
+
+// NewDailyManager tworzy nowy manager wyzwań dnia
+func NewDailyManager(filePath string) (*DailyManager, error) {
+	dm := &DailyManager{
+		filePath: filePath,
+		stats: DailyStats{
+			Profiles: make(map[string][]DailyResult),
+		},
+	}
+
+	// Spróbuj odczytać zapisane wyniki
+	_, err := os.Stat(filePath)
+	if err == nil {
+		err = dm.loadResults()
+		if err != nil {
+			return nil, err
+		}
+	} else if !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	return dm, nil
+}
+
+// loadResults wczytuje wyniki wyzwań dnia z pliku
+func (dm *DailyManager) loadResults() error {
+	data, err := os.ReadFile(dm.filePath)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(data, &dm.stats)
+	if err != nil {
+		return err
+	}
+
+	if dm.stats.Profiles == nil {
+		dm.stats.Profiles = make(map[string][]DailyResult)
+	}
+
+	return nil
+}
+
+// Save zapisuje wyniki wyzwań dnia do pliku
+func (dm *DailyManager) Save() error {
+	data, err := json.MarshalIndent(dm.stats, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(dm.filePath, data, 0644)
+}
+
+// GetResult zwraca wynik wyzwania z podanego dnia dla profilu
+func (dm *DailyManager) GetResult(profile string, date string) (DailyResult, bool) {
+	for _, result := range dm.stats.Profiles[profile] {
+		if result.Date == date {
+			return result, true
+		}
+	}
+	return DailyResult{}, false
+}
+
+// HasPlayed sprawdza czy profil rozegrał już wyzwanie z podanego dnia
+func (dm *DailyManager) HasPlayed(profile string, date string) bool {
+	_, ok := dm.GetResult(profile, date)
+	return ok
+}
+
+// RecordResult zapisuje wynik wyzwania dnia dla profilu
+// (zastępuje wcześniejszy wynik z tego samego dnia, np. DailyStarted)
+func (dm *DailyManager) RecordResult(profile string, result DailyResult) error {
+	if result.PlayedAt.IsZero() {
+		result.PlayedAt = time.Now()
+	}
+
+	results := dm.stats.Profiles[profile]
+	for i := range results {
+		if results[i].Date == result.Date {
+			results[i] = result
+			return dm.Save()
+		}
+	}
+
+	dm.stats.Profiles[profile] = append(results, result)
+	return dm.Save()
+}
+
+// GetResults zwraca wszystkie wyniki wyzwań dnia profilu
+func (dm *DailyManager) GetResults(profile string) []DailyResult {
+	return dm.stats.Profiles[profile]
+}
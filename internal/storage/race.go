@@ -0,0 +1,95 @@
+package storage
+
+import (
+	"sort"
+	"time"
+
+	"github.com/r3per/hanged-game/internal/game"
+)
+
+// RaceRecord reprezentuje wynik jednego wyścigu
+type RaceRecord struct {
+	Word         string              `json:"word"`
+	DifficultyID string              `json:"difficulty_id,omitempty"` // Identyfikator poziomu trudności
+	Scoring      string              `json:"scoring,omitempty"`       // Nazwa zasad punktacji
+	Language     string              `json:"language,omitempty"`      // Kod języka słowa
+	Seed         int64               `json:"seed,omitempty"`          // Ziarno losowości gry
+	Room         string              `json:"room,omitempty"`          // Pokój serwera (pusty dla gry lokalnej)
+	Standings    []game.RaceStanding `json:"standings"`               // Klasyfikacja graczy
+	Date         time.Time           `json:"date"`
+}
+
+// NewRaceRecord tworzy wpis wyścigu na podstawie zakończonego wyścigu
+func NewRaceRecord(race *game.Race, room string) RaceRecord {
+	record := RaceRecord{
+		Room:      room,
+		Standings: race.Standings(),
+		Date:      time.Now(),
+	}
+	if len(race.Players) > 0 {
+		g := race.Players[0].Game
+		record.Word = g.Word
+		record.DifficultyID = g.Difficulty.ID
+		record.Scoring = g.Scoring.Name()
+		record.Language = g.Language
+		record.Seed = g.Seed
+	}
+	return record
+}
+
+// RecordRace dodaje wynik wyścigu do statystyk
+func (sm *StatsManager) RecordRace(record RaceRecord) error {
+	if record.Date.IsZero() {
+		record.Date = time.Now()
+	}
+
+	sm.stats.Races = append(sm.stats.Races, record)
+	return sm.saveStats()
+}
+
+// RaceScore reprezentuje wyniki gracza ze wszystkich wyścigów
+type RaceScore struct {
+	Name        string // Nazwa gracza
+	Races       int    // Liczba wyścigów
+	Wins        int    // Wygrane wyścigi (pierwsze odgadnięcie słowa)
+	Podiums     int    // Miejsca od pierwszego do trzeciego
+	TotalPoints int    // Suma punktów
+}
+
+// GetRaceScoreboard zwraca tabelę wyników wyścigów: najpierw najwięcej wygranych, potem podia i punkty
+func (sm *StatsManager) GetRaceScoreboard() []RaceScore {
+	var scores []RaceScore
+	index := make(map[string]int)
+
+	for _, race := range sm.stats.Races {
+		for _, standing := range race.Standings {
+			i, ok := index[standing.Name]
+			if !ok {
+				i = len(scores)
+				index[standing.Name] = i
+				scores = append(scores, RaceScore{Name: standing.Name})
+			}
+
+			score := &scores[i]
+			score.Races++
+			score.TotalPoints += standing.Points
+			if standing.State == game.StateWon {
+				score.Wins++
+			}
+			if standing.Rank <= 3 {
+				score.Podiums++
+			}
+		}
+	}
+
+	sort.SliceStable(scores, func(a, b int) bool {
+		if scores[a].Wins != scores[b].Wins {
+			return scores[a].Wins > scores[b].Wins
+		}
+		if scores[a].Podiums != scores[b].Podiums {
+			return scores[a].Podiums > scores[b].Podiums
+		}
+		return scores[a].TotalPoints > scores[b].TotalPoints
+	})
+	return scores
+}
@@ -4,24 +4,73 @@ import (
 	"encoding/json"
 	"os"
 	"time"
+
+	"github.com/r3per/hanged-game/internal/game"
 )
 
 // GameStats reprezentuje statystyki pojedynczej gry
 type GameStats struct {
-	Word       string    `json:"word"`
-	Result     string    `json:"result"` // "win" lub "lose"
-	Points     int       `json:"points"`
-	Difficulty int       `json:"difficulty"`
-	Date       time.Time `json:"date"`
+	Word          string              `json:"word"`
+	Result        string              `json:"result"` // "win" lub "lose"
+	Points        int                 `json:"points"`
+	Difficulty    int                 `json:"difficulty,omitempty"`     // Liczba prób (tylko w starszych wpisach)
+	DifficultyID  string              `json:"difficulty_id,omitempty"`  // Identyfikator poziomu trudności
+	Scoring       string              `json:"scoring,omitempty"`        // Nazwa zasad punktacji
+	Language      string              `json:"language,omitempty"`       // Kod języka słowa
+	Seed          int64               `json:"seed"`                     // Ziarno losowości gry (efekty atrybutów i przedmiotów)
+	Mode          string              `json:"mode,omitempty"`           // Tryb gry (pusty dla zwykłej gry)
+	StartWord     string              `json:"start_word,omitempty"`     // Słowo z początku gry, gdy różni się od Word (tryb złośliwego wisielca)
+	Modifiers     *game.GameModifiers `json:"modifiers,omitempty"`      // Modyfikatory z atrybutów RPG
+	StrictLetters bool                `json:"strict_letters,omitempty"` // Czy litery ze znakami diakrytycznymi odgadywano osobno
+	AdvisorHints  bool                `json:"advisor_hints,omitempty"`  // Czy Inteligencja podsuwała literę od doradcy
+	Setter        string              `json:"setter,omitempty"`         // Gracz, który wpisał słowo (gra dwuosobowa)
+	Guesser       string              `json:"guesser,omitempty"`        // Gracz, który odgadywał słowo (gra dwuosobowa)
+	Date          time.Time           `json:"date"`
+}
+
+// GetDifficultyID zwraca identyfikator poziomu trudności gry
+// (dla starszych wpisów odtwarzany z liczby prób)
+func (gs GameStats) GetDifficultyID() string {
+	if gs.DifficultyID != "" {
+		return gs.DifficultyID
+	}
+
+	switch gs.Difficulty {
+	case game.EasyLevel:
+		return game.DifficultyEasy
+	case game.MediumLevel:
+		return game.DifficultyMedium
+	case game.HardLevel:
+		return game.DifficultyHard
+	default:
+		return ""
+	}
+}
+
+// Replayable sprawdza czy wpis zawiera wszystko, co potrzebne do odtworzenia gry
+// (starsze wpisy nie mają zapisanych modyfikatorów)
+func (gs GameStats) Replayable() bool {
+	return gs.Modifiers != nil
+}
+
+// GetReplayWord zwraca słowo, od którego zaczęła się gra
+func (gs GameStats) GetReplayWord() string {
+	if gs.StartWord != "" {
+		return gs.StartWord
+	}
+	return gs.Word
 }
 
 // PlayerStats reprezentuje statystyki gracza
 type PlayerStats struct {
-	GamesPlayed  int         `json:"games_played"`
-	GamesWon     int         `json:"games_won"`
-	TotalPoints  int         `json:"total_points"`
-	HighestScore int         `json:"highest_score"`
-	GameHistory  []GameStats `json:"game_history"`
+	GamesPlayed  int          `json:"games_played"`
+	GamesWon     int          `json:"games_won"`
+	TotalPoints  int          `json:"total_points"`
+	HighestScore int          `json:"highest_score"`
+	GameHistory  []GameStats  `json:"game_history"`
+	Races        []RaceRecord `json:"races,omitempty"` // Wyniki wyścigów
+	// Gry dwuosobowe - nie wliczają się do statystyk gracza ani do ostatnio rozegranych słów
+	TwoPlayerGames []GameStats `json:"two_player_games,omitempty"`
 }
 
 // StatsManager zarządza statystykami gracza
@@ -85,25 +134,30 @@ func (sm *StatsManager) saveStats() error {
 
 // AddGameResult dodaje wynik gry do statystyk
 func (sm *StatsManager) AddGameResult(word string, result string, points int, difficulty int) error {
-	// Utwórz nowe statystyki gry
-	gameStats := GameStats{
+	return sm.RecordGame(GameStats{
 		Word:       word,
 		Result:     result,
 		Points:     points,
 		Difficulty: difficulty,
-		Date:       time.Now(),
+	})
+}
+
+// RecordGame dodaje wpis gry do statystyk
+func (sm *StatsManager) RecordGame(gameStats GameStats) error {
+	if gameStats.Date.IsZero() {
+		gameStats.Date = time.Now()
 	}
 
 	// Aktualizuj statystyki gracza
 	sm.stats.GamesPlayed++
-	sm.stats.TotalPoints += points
+	sm.stats.TotalPoints += gameStats.Points
 
-	if result == "win" {
+	if gameStats.Result == "win" {
 		sm.stats.GamesWon++
 	}
 
-	if points > sm.stats.HighestScore {
-		sm.stats.HighestScore = points
+	if gameStats.Points > sm.stats.HighestScore {
+		sm.stats.HighestScore = gameStats.Points
 	}
 
 	// Dodaj statystyki gry do historii
@@ -113,6 +167,16 @@ func (sm *StatsManager) AddGameResult(word string, result string, points int, di
 	return sm.saveStats()
 }
 
+// RecordTwoPlayerGame dodaje wynik gry dwuosobowej (zmienia tylko wyniki nazwanych graczy)
+func (sm *StatsManager) RecordTwoPlayerGame(gameStats GameStats) error {
+	if gameStats.Date.IsZero() {
+		gameStats.Date = time.Now()
+	}
+
+	sm.stats.TwoPlayerGames = append(sm.stats.TwoPlayerGames, gameStats)
+	return sm.saveStats()
+}
+
 // GetStats zwraca statystyki gracza
 func (sm *StatsManager) GetStats() PlayerStats {
 	return sm.stats
@@ -150,6 +214,68 @@ func (sm *StatsManager) GetLastGames(n int) []GameStats {
 	return history[historyLen-n:]
 }
 
+// GetGameFromEnd zwraca n-tą grę od końca historii (1 = ostatnia rozegrana gra)
+func (sm *StatsManager) GetGameFromEnd(n int) (GameStats, bool) {
+	history := sm.stats.GameHistory
+	if n < 1 || n > len(history) {
+		return GameStats{}, false
+	}
+	return history[len(history)-n], true
+}
+
+// GetRecentWords zwraca słowa z ostatnich n gier
+func (sm *StatsManager) GetRecentWords(n int) []string {
+	lastGames := sm.GetLastGames(n)
+	words := make([]string, 0, len(lastGames))
+	for _, gameStats := range lastGames {
+		words = append(words, gameStats.Word)
+	}
+	return words
+}
+
+// PlayerRecord reprezentuje wyniki nazwanego gracza z gier dwuosobowych
+type PlayerRecord struct {
+	Name          string // Nazwa gracza
+	GamesGuessed  int    // Gry, w których gracz odgadywał słowo
+	WordsGuessed  int    // Słowa odgadnięte przez gracza
+	GamesSet      int    // Gry, w których gracz wpisał słowo
+	WordsUnsolved int    // Wpisane przez gracza słowa, których nie odgadnięto
+}
+
+// GetPlayerRecords zwraca wyniki graczy z gier dwuosobowych (w kolejności pierwszej gry)
+func (sm *StatsManager) GetPlayerRecords() []PlayerRecord {
+	var records []PlayerRecord
+	index := make(map[string]int)
+	record := func(name string) *PlayerRecord {
+		i, ok := index[name]
+		if !ok {
+			i = len(records)
+			index[name] = i
+			records = append(records, PlayerRecord{Name: name})
+		}
+		return &records[i]
+	}
+
+	for _, gameStats := range sm.stats.TwoPlayerGames {
+		if gameStats.Guesser != "" {
+			guesser := record(gameStats.Guesser)
+			guesser.GamesGuessed++
+			if gameStats.Result == "win" {
+				guesser.WordsGuessed++
+			}
+		}
+		if gameStats.Setter != "" {
+			setter := record(gameStats.Setter)
+			setter.GamesSet++
+			if gameStats.Result != "win" {
+				setter.WordsUnsolved++
+			}
+		}
+	}
+
+	return records
+}
+
 // ResetStats resetuje statystyki gracza
 func (sm *StatsManager) ResetStats() error {
 	sm.stats = PlayerStats{
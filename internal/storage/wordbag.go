@@ -0,0 +1,69 @@
+package storage
+
+import (
+	"encoding/json"
+	"os"
+
+	"github.com/r3per/hanged-game/internal/game"
+)
+
+// WordBagManager zarządza zapisem worka słów między sesjami
+type WordBagManager struct {
+	bag      *game.ShuffleBag
+	filePath string
+}
+
+// NewWordBagManager tworzy nowy manager worka słów
+func NewWordBagManager(filePath string) (*WordBagManager, error) {
+	bm := &WordBagManager{
+		filePath: filePath,
+		bag:      game.NewShuffleBag(),
+	}
+
+	// Spróbuj odczytać zapisany worek słów
+	_, err := os.Stat(filePath)
+	if err == nil {
+		err = bm.loadBag()
+		if err != nil {
+			return nil, err
+		}
+	} else if !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	return bm, nil
+}
+
+// loadBag wczytuje worek słów z pliku
+func (bm *WordBagManager) loadBag() error {
+	data, err := os.ReadFile(bm.filePath)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(data, bm.bag)
+	if err != nil {
+		return err
+	}
+
+	if bm.bag.Remaining == nil {
+		bm.bag.Remaining = make(map[string][]string)
+	}
+
+	return nil
+}
+
+// GetBag zwraca worek słów
+func (bm *WordBagManager) GetBag() *game.ShuffleBag {
+	return bm.bag
+}
+
+// Save zapisuje worek słów do pliku
+func (bm *WordBagManager) Save() error {
+	data, err := json.MarshalIndent(bm.bag, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(bm.filePath, data, 0644)
+}
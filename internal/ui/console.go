@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"unicode"
 	"unicode/utf8"
 
 	"github.com/r3per/hanged-game/internal/game"
@@ -30,6 +31,12 @@ const (
 	BgWhite  = "\033[47m"
 )
 
+// Polecenia dostępne w trakcie gry
+const (
+	ItemMenuKey = "!" // Otwiera menu przedmiotów
+	HintKey     = "?" // Odkrywa podpowiedź za punkty
+)
+
 // Domyślna szerokość terminala
 const (
 	DefaultTerminalWidth = 80
@@ -40,6 +47,7 @@ type ConsoleUI struct {
 	reader        *bufio.Reader
 	hangman       *game.HangmanDrawing
 	showProgress  bool
+	debugOverlay  bool
 	terminalWidth int
 }
 
@@ -53,6 +61,11 @@ func NewConsoleUI() *ConsoleUI {
 	}
 }
 
+// SetDebugOverlay włącza lub wyłącza nakładkę diagnostyczną doradcy na ekranie gry
+func (ui *ConsoleUI) SetDebugOverlay(enabled bool) {
+	ui.debugOverlay = enabled
+}
+
 // CenterText centruje tekst w konsoli
 func (ui *ConsoleUI) CenterText(text string) string {
 	lines := strings.Split(text, "\n")
@@ -118,35 +131,103 @@ func (ui *ConsoleUI) ClearScreen() {
 
 // PrintGameState wyświetla aktualny stan gry
 func (ui *ConsoleUI) PrintGameState(g *game.Game) {
+	ui.printGameView(g.View())
+
+	// Wyświetl nakładkę diagnostyczną doradcy (opcjonalnie)
+	if ui.debugOverlay && g.Advisor != nil {
+		ui.printAdvisorOverlay(g)
+	}
+
+	fmt.Println()
+}
+
+// PrintGameView wyświetla stan gry na podstawie widoku (np. otrzymanego z serwera)
+func (ui *ConsoleUI) PrintGameView(view game.GameView) {
+	ui.printGameView(view)
+	fmt.Println()
+}
+
+// printGameView wyświetla rysunek wisielca, słowo i informacje o przebiegu gry
+func (ui *ConsoleUI) printGameView(view game.GameView) {
 	// Wyświetl rysunek wisielca
-	hangmanDrawing := White + ui.hangman.GetDrawing(len(g.WrongGuesses)) + Reset
+	hangmanDrawing := White + ui.hangman.GetDrawing(view.UsedAttempts) + Reset
 	fmt.Println(ui.CenterText(hangmanDrawing))
 
 	// Wyświetl słowo z odgadniętymi literami
-	wordWithGuesses := g.GetWordWithGuesses()
-	fmt.Println(ui.CenterText(Bold + Blue + "\nSłowo: " + White + wordWithGuesses + Reset))
+	fmt.Println(ui.CenterText(Bold + Blue + "\nSłowo: " + White + view.Pattern + Reset))
+
+	// Wyświetl kategorię i odkrytą podpowiedź
+	if view.Category != "" {
+		fmt.Println(ui.CenterText(Bold + Purple + "Kategoria: " + White + view.Category + Reset))
+	}
+	if view.Hint != "" {
+		fmt.Println(ui.CenterText(Bold + Purple + "Podpowiedź: " + White + view.Hint + Reset))
+	}
 
 	// Wyświetl błędne próby
-	wrongGuesses := g.GetWrongGuesses()
-	if wrongGuesses != "" {
-		fmt.Println(ui.CenterText(Bold + Red + "Błędne próby: " + White + wrongGuesses + Reset))
+	if view.WrongGuesses != "" {
+		fmt.Println(ui.CenterText(Bold + Red + "Błędne próby: " + White + view.WrongGuesses + Reset))
+	}
+
+	// Wyświetl błędnie podane słowa
+	if len(view.WrongWords) > 0 {
+		fmt.Println(ui.CenterText(Bold + Red + "Błędne słowa: " + White + strings.Join(view.WrongWords, ", ") + Reset))
+	}
+
+	// Wyświetl błędy wybaczone dzięki szczęściu
+	if view.ForgivenGuesses != "" {
+		fmt.Println(ui.CenterText(Bold + Green + "Wybaczone błędy: " + White + view.ForgivenGuesses + Reset))
 	}
 
 	// Wyświetl pozostałe próby
-	remainingAttempts := g.GetRemainingAttempts()
-	fmt.Println(ui.CenterText(Bold + Yellow + "Pozostałe próby: " + White + fmt.Sprintf("%d", remainingAttempts) + Reset))
+	fmt.Println(ui.CenterText(Bold + Yellow + "Pozostałe próby: " + White + fmt.Sprintf("%d", view.RemainingAttempts) + Reset))
 
 	// Wyświetl punkty
-	fmt.Println(ui.CenterText(Bold + Green + "Punkty: " + White + fmt.Sprintf("%d", g.Points) + Reset))
+	fmt.Println(ui.CenterText(Bold + Green + "Punkty: " + White + fmt.Sprintf("%d", view.Points) + Reset))
 
 	// Wyświetl postęp (opcjonalnie)
 	if ui.showProgress {
-		progress := g.GetProgress()
-		progressText := fmt.Sprintf(Bold+Cyan+"Postęp: "+White+"%.1f%%"+Reset, progress)
+		progressText := fmt.Sprintf(Bold+Cyan+"Postęp: "+White+"%.1f%%"+Reset, view.Progress)
 		fmt.Println(ui.CenterText(progressText))
 	}
 
-	fmt.Println()
+	// Wyświetl atrybuty, które zadziałały w ostatnim ruchu
+	for _, trigger := range view.Triggers {
+		fmt.Println(ui.CenterText(formatAttributeTrigger(trigger)))
+	}
+}
+
+// printAdvisorOverlay wyświetla liczbę pasujących słów i najczęstsze litery wśród nich
+func (ui *ConsoleUI) printAdvisorOverlay(g *game.Game) {
+	candidates := g.Advisor.Candidates(g)
+
+	var letters []string
+	for i, suggestion := range g.Advisor.Suggest(g) {
+		if i >= 5 {
+			break
+		}
+		letters = append(letters, fmt.Sprintf("%c %.0f%%", suggestion.Letter, suggestion.Share))
+	}
+
+	fmt.Println(ui.CenterText(Cyan + fmt.Sprintf("[debug] Kandydaci: %d | Litery: %s", len(candidates), strings.Join(letters, ", ")) + Reset))
+}
+
+// formatAttributeTrigger zwraca komunikat o zadziałaniu atrybutu
+func formatAttributeTrigger(trigger game.AttributeTrigger) string {
+	switch trigger.Attribute {
+	case game.TriggerResilience:
+		return Purple + fmt.Sprintf("Odporność: +%d dodatkowych prób!", trigger.Value) + Reset
+	case game.TriggerPerception:
+		return Purple + fmt.Sprintf("Percepcja: +%d pkt za trafienie!", trigger.Value) + Reset
+	case game.TriggerLuck:
+		return Purple + fmt.Sprintf("Szczęście: błąd '%c' nie kosztował próby!", trigger.Letter) + Reset
+	case game.TriggerIntelligence:
+		return Purple + fmt.Sprintf("Inteligencja: darmowa podpowiedź - litera '%c'!", trigger.Letter) + Reset
+	case game.TriggerAdvice:
+		return Purple + fmt.Sprintf("Inteligencja: spróbuj litery '%c' (pasuje do %d%% możliwych słów)!", trigger.Letter, trigger.Value) + Reset
+	default:
+		return ""
+	}
 }
 
 // PrintWinMessage wyświetla wiadomość o wygranej
@@ -178,14 +259,25 @@ func (ui *ConsoleUI) PrintMenu() {
 }
 
 // PrintDifficultyMenu wyświetla menu wyboru poziomu trudności
-func (ui *ConsoleUI) PrintDifficultyMenu() {
+func (ui *ConsoleUI) PrintDifficultyMenu(difficulties []game.Difficulty) {
 	fmt.Println(ui.CenterText(Bold + Yellow + "=== POZIOM TRUDNOŚCI ===" + Reset))
-	fmt.Println(ui.CenterText(Bold + "1. " + Reset + "Łatwy (8 prób)"))
-	fmt.Println(ui.CenterText(Bold + "2. " + Reset + "Średni (6 prób)"))
-	fmt.Println(ui.CenterText(Bold + "3. " + Reset + "Trudny (4 próby)"))
+	for i, difficulty := range difficulties {
+		fmt.Println(ui.CenterText(Bold + fmt.Sprintf("%d. ", i+1) + Reset +
+			fmt.Sprintf("%s (próby: %d, punkty x%.1f)", difficulty.Name, difficulty.Attempts, difficulty.ScoreMultiplier)))
+	}
 	fmt.Print(ui.CenterText(Bold + "\nWybierz poziom trudności: " + Reset))
 }
 
+// PrintGameModeMenu wyświetla menu wyboru trybu gry
+func (ui *ConsoleUI) PrintGameModeMenu(modes []string) {
+	fmt.Println(ui.CenterText(Bold + Yellow + "=== TRYBY GRY ===" + Reset))
+	for i, mode := range modes {
+		fmt.Println(ui.CenterText(Bold + fmt.Sprintf("%d. ", i+1) + Reset + mode))
+	}
+	fmt.Println(ui.CenterText(Bold + "0. " + Reset + "Powrót do menu"))
+	fmt.Print(ui.CenterText(Bold + "\nWybierz tryb gry: " + Reset))
+}
+
 // GetInput pobiera wejście od użytkownika
 func (ui *ConsoleUI) GetInput() string {
 	input, _ := ui.reader.ReadString('\n')
@@ -193,6 +285,51 @@ func (ui *ConsoleUI) GetInput() string {
 	return input
 }
 
+// GetSecretInput pobiera tekst bez wyświetlania wpisywanych znaków (np. tajne słowo)
+// Jeśli terminal nie pozwala wyłączyć echa, tekst jest ukrywany kodem ANSI i ekran jest czyszczony
+func (ui *ConsoleUI) GetSecretInput() string {
+	kr := NewKeyboardReader()
+	if err := kr.SetEcho(false); err != nil {
+		fmt.Print("\033[8m") // Ukryj wpisywany tekst
+		input := ui.GetInput()
+		fmt.Print(Reset)
+		ui.ClearScreen()
+		return input
+	}
+	defer kr.SetEcho(true)
+
+	input := ui.GetInput()
+	fmt.Println()
+	return input
+}
+
+// GetKey pobiera pojedynczy znak, pomijając Enter i spacje (0 po zamknięciu wejścia)
+// Gdy terminal buforuje linie (KeyboardReader.SetLineMode nie powiodło się), odczytuje całą linię,
+// zwraca jej pierwszy znak i odrzuca resztę, aby nadmiarowe znaki nie trafiły do następnego ruchu
+func (ui *ConsoleUI) GetKey(lineBuffered bool) rune {
+	for {
+		if lineBuffered {
+			line, err := ui.reader.ReadString('\n')
+			if line = strings.TrimSpace(line); line != "" {
+				key, _ := utf8.DecodeRuneInString(line)
+				return key
+			}
+			if err != nil {
+				return 0
+			}
+			continue
+		}
+
+		key, _, err := ui.reader.ReadRune()
+		if err != nil {
+			return 0
+		}
+		if !unicode.IsSpace(key) {
+			return key
+		}
+	}
+}
+
 // GetMenuOption pobiera opcję menu od użytkownika
 func (ui *ConsoleUI) GetMenuOption() int {
 	input := ui.GetInput()
@@ -201,24 +338,63 @@ func (ui *ConsoleUI) GetMenuOption() int {
 	return option
 }
 
-// GetLetterInput pobiera literę od użytkownika
-func (ui *ConsoleUI) GetLetterInput() rune {
+// GameInput reprezentuje ruch gracza w trakcie gry
+type GameInput struct {
+	Letter  rune   // Podana litera
+	Word    string // Podane całe słowo
+	UseItem bool   // Czy gracz chce użyć przedmiotu
+	UseHint bool   // Czy gracz chce odkryć podpowiedź
+}
+
+// GetLetterInput pobiera literę lub całe słowo od użytkownika
+// Litery i słowa są sprawdzane według alfabetu języka odgadywanego słowa
+func (ui *ConsoleUI) GetLetterInput(alphabet game.Alphabet) GameInput {
 	for {
-		fmt.Print(ui.CenterText(Bold + "Podaj literę: " + Reset))
+		fmt.Print(ui.CenterText(Bold + "Podaj literę lub całe słowo (" + ItemMenuKey + " - przedmioty, " +
+			HintKey + fmt.Sprintf(" - podpowiedź za %d pkt): ", game.HintCost) + Reset))
 		input := ui.GetInput()
 
 		if input == "" {
 			continue
 		}
 
-		// Pobierz pierwszą literę z wejścia
-		r, _ := utf8.DecodeRuneInString(input)
-		if game.IsPolishLetter(r) {
-			return r
+		if input == ItemMenuKey {
+			return GameInput{UseItem: true}
 		}
 
-		fmt.Println(ui.CenterText(Red + "Nieprawidłowy znak. Wprowadź literę alfabetu." + Reset))
+		if input == HintKey {
+			return GameInput{UseHint: true}
+		}
+
+		if utf8.RuneCountInString(input) > 1 {
+			// Całe słowo lub fraza może zawierać litery, spacje i znaki interpunkcyjne
+			if isWord(input, alphabet) {
+				return GameInput{Word: input}
+			}
+		} else {
+			r, _ := utf8.DecodeRuneInString(input)
+			if alphabet.IsLetter(r) {
+				return GameInput{Letter: r}
+			}
+		}
+
+		fmt.Println(ui.CenterText(Red + "Nieprawidłowy znak. Wprowadź literę alfabetu lub całe słowo." + Reset))
+	}
+}
+
+// isWord sprawdza czy napis jest słowem lub frazą (litery, spacje, myślniki, apostrofy)
+func isWord(input string, alphabet game.Alphabet) bool {
+	letters := 0
+	for _, r := range input {
+		switch {
+		case alphabet.IsLetter(r):
+			letters++
+		case r == ' ' || r == '-' || r == '\'':
+		default:
+			return false
+		}
 	}
+	return letters > 1
 }
 
 // ToggleProgressDisplay przełącza wyświetlanie postępu
@@ -232,20 +408,19 @@ func (ui *ConsoleUI) WaitForEnter() {
 	ui.GetInput()
 }
 
-// SetupGame konfiguruje nową grę
-func (ui *ConsoleUI) SetupGame(wordsManager *game.WordsManager) *game.Game {
-	// Wybierz poziom trudności
-	ui.ClearScreen()
-	ui.PrintDifficultyMenu()
+// SetupGame konfiguruje nową grę na wybranym poziomie trudności
+func (ui *ConsoleUI) SetupGame(wordsManager *game.WordsManager, difficulty game.Difficulty, modifiers game.GameModifiers, scoring game.ScoringPolicy) *game.Game {
+	// Wybierz losowe słowo pasujące do poziomu trudności
+	word := wordsManager.GetRandomWordForDifficulty(difficulty)
 
-	difficultyLevel := ui.GetMenuOption()
-	if difficultyLevel < 1 || difficultyLevel > 3 {
-		difficultyLevel = 2 // Domyślnie średni poziom
-	}
+	// Utwórz nową grę (z ziarnem z generatora managera słów, aby dało się ją odtworzyć)
+	return game.NewSeededGame(word, wordsManager.GetWords(), "", difficulty, modifiers, scoring, wordsManager.NewGameSeed())
+}
 
-	// Wybierz losowe słowo
-	word := wordsManager.GetRandomWord()
+// SetupEvilGame konfiguruje nową grę w trybie złośliwego wisielca na wybranym poziomie trudności
+func (ui *ConsoleUI) SetupEvilGame(wordsManager *game.WordsManager, difficulty game.Difficulty, modifiers game.GameModifiers, scoring game.ScoringPolicy) *game.Game {
+	// Słowo startowe wyznacza tylko kształt słowa; kandydatami jest cała aktywna paczka
+	word := wordsManager.GetRandomWordForDifficulty(difficulty)
 
-	// Utwórz nową grę
-	return game.NewGame(word, difficultyLevel)
+	return game.NewSeededGame(word, wordsManager.GetWords(), game.ModeEvil, difficulty, modifiers, scoring, wordsManager.NewGameSeed())
 }
@@ -3,6 +3,7 @@ package ui
 import (
 	"fmt"
 	"os"
+	"os/exec"
 )
 
 // Kody klawiszy specjalnych
@@ -54,6 +55,31 @@ func (kr *KeyboardReader) DisableRawMode() {
 	kr.oldState.raw = false
 }
 
+// SetEcho włącza lub wyłącza wyświetlanie wpisywanych znaków (przez polecenie stty)
+func (kr *KeyboardReader) SetEcho(enabled bool) error {
+	mode := "-echo"
+	if enabled {
+		mode = "echo"
+	}
+
+	cmd := exec.Command("stty", mode)
+	cmd.Stdin = os.Stdin
+	return cmd.Run()
+}
+
+// SetLineMode włącza lub wyłącza buforowanie linii w terminalu (przez polecenie stty)
+// Po wyłączeniu znaki są dostępne od razu, bez naciskania Enter
+func (kr *KeyboardReader) SetLineMode(enabled bool) error {
+	args := []string{"-icanon", "min", "1"}
+	if enabled {
+		args = []string{"icanon"}
+	}
+
+	cmd := exec.Command("stty", args...)
+	cmd.Stdin = os.Stdin
+	return cmd.Run()
+}
+
 // ReadKey odczytuje pojedynczy klawisz
 func (kr *KeyboardReader) ReadKey() (rune, error) {
 	// Bufor na jeden znak
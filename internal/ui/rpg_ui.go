@@ -148,7 +148,7 @@ func (rui *RPGCharacterUI) PrintInventory(consoleUI *ConsoleUI) {
 	// Przygotuj informacje o ekwipunku
 	inventoryContent := []string{
 		Bold + Yellow + fmt.Sprintf("Przedmioty (%d/%d):",
-			len(rui.rpgLevel.Inventory.Items),
+			rui.rpgLevel.Inventory.ItemCount(),
 			rui.rpgLevel.Inventory.MaxCapacity) + Reset,
 		"",
 	}
@@ -188,25 +188,7 @@ func (rui *RPGCharacterUI) PrintInventory(consoleUI *ConsoleUI) {
 			inventoryContent = append(inventoryContent, itemLine+usedStatus)
 
 			// Dodaj efekty przedmiotu
-			effectsLine := "   Efekty: "
-			for j, effect := range item.Effects {
-				if j > 0 {
-					effectsLine += ", "
-				}
-
-				switch effect.Type {
-				case "reveal_letter":
-					effectsLine += fmt.Sprintf("Odkryj %d literę", effect.Value)
-				case "extra_life":
-					effectsLine += fmt.Sprintf("+%d życie", effect.Value)
-				case "intelligence_boost":
-					effectsLine += fmt.Sprintf("+%d inteligencji", effect.Value)
-				case "luck_boost":
-					effectsLine += fmt.Sprintf("+%d szczęścia", effect.Value)
-				default:
-					effectsLine += fmt.Sprintf("%s: %d", effect.Type, effect.Value)
-				}
-			}
+			effectsLine := "   Efekty: " + formatItemEffects(item.Effects)
 
 			inventoryContent = append(inventoryContent, effectsLine)
 			inventoryContent = append(inventoryContent, "")
@@ -221,15 +203,15 @@ func (rui *RPGCharacterUI) PrintInventory(consoleUI *ConsoleUI) {
 
 // PrintRPGGameStats wyświetla statystyki gry w stylu RPG
 func (rui *RPGCharacterUI) PrintRPGGameStats(consoleUI *ConsoleUI, g *game.Game) {
-	// Dodatkowe atrybuty z bonusami RPG
-	extraLives := rui.rpgLevel.Attributes.GetResilienceBonus()
-	pointsBonus := rui.rpgLevel.Attributes.GetPerceptionBonus()
+	// Dodatkowe atrybuty z bonusami RPG (już uwzględnione w grze)
+	extraLives := g.Modifiers.ExtraAttempts
+	pointsBonus := g.Modifiers.PointsPerHit
 
 	// Przygotuj informacje o grze
 	gameStatsContent := []string{
 		Bold + Blue + "Słowo: " + White + g.GetWordWithGuesses() + Reset,
 		"",
-		Bold + Yellow + "Pozostałe próby: " + White + fmt.Sprintf("%d (+%d)", g.GetRemainingAttempts(), extraLives) + Reset,
+		Bold + Yellow + "Pozostałe próby: " + White + fmt.Sprintf("%d (w tym +%d z odporności)", g.GetRemainingAttempts(), extraLives) + Reset,
 		Bold + Green + "Punkty: " + White + fmt.Sprintf("%d (+%d za trafienie)", g.Points, pointsBonus) + Reset,
 	}
 
@@ -255,7 +237,10 @@ func (rui *RPGCharacterUI) PrintRPGMainMenu(consoleUI *ConsoleUI) {
 		Bold + "4. " + Reset + "Pokaż ekwipunek",
 		Bold + "5. " + Reset + "Pokaż dziennik zadań",
 		Bold + "6. " + Reset + "Sklep z przedmiotami",
-		Bold + "7. " + Reset + "Wyjście",
+		Bold + "7. " + Reset + "Wybierz język",
+		Bold + "8. " + Reset + "Wyzwanie dnia",
+		Bold + "9. " + Reset + "Inne tryby gry",
+		Bold + "10. " + Reset + "Wyjście",
 		"",
 		Bold + Yellow + "Poziom postaci: " + Reset + fmt.Sprintf("%d | XP: %d/%d",
 			rui.rpgLevel.Level, rui.rpgLevel.Experience, rui.rpgLevel.NextLevelXP),
@@ -310,36 +295,22 @@ func (rui *RPGCharacterUI) PrintItemShop(consoleUI *ConsoleUI, shopItems []game.
 		"",
 	}
 
-	// Ceny przedmiotów (zależne od rzadkości)
-	prices := map[string]int{
-		"common":    50,
-		"uncommon":  100,
-		"rare":      250,
-		"epic":      500,
-		"legendary": 1000,
-	}
-
 	for i, item := range shopItems {
 		// Koloruj przedmioty w zależności od rzadkości
 		rarityColor := White
-		price := 0
+		price := game.GetItemPrice(item)
 
 		switch item.Rarity {
 		case "common":
 			rarityColor = White
-			price = prices["common"]
 		case "uncommon":
 			rarityColor = Green
-			price = prices["uncommon"]
 		case "rare":
 			rarityColor = Blue
-			price = prices["rare"]
 		case "epic":
 			rarityColor = Purple
-			price = prices["epic"]
 		case "legendary":
 			rarityColor = Yellow
-			price = prices["legendary"]
 		}
 
 		// Sprawdź, czy gracz może kupić przedmiot
@@ -361,25 +332,7 @@ func (rui *RPGCharacterUI) PrintItemShop(consoleUI *ConsoleUI, shopItems []game.
 		shopContent = append(shopContent, fmt.Sprintf("   Cena: %s", priceText))
 
 		// Dodaj efekty przedmiotu
-		effectsLine := "   Efekty: "
-		for j, effect := range item.Effects {
-			if j > 0 {
-				effectsLine += ", "
-			}
-
-			switch effect.Type {
-			case "reveal_letter":
-				effectsLine += fmt.Sprintf("Odkryj %d literę", effect.Value)
-			case "extra_life":
-				effectsLine += fmt.Sprintf("+%d życie", effect.Value)
-			case "intelligence_boost":
-				effectsLine += fmt.Sprintf("+%d inteligencji", effect.Value)
-			case "luck_boost":
-				effectsLine += fmt.Sprintf("+%d szczęścia", effect.Value)
-			default:
-				effectsLine += fmt.Sprintf("%s: %d", effect.Type, effect.Value)
-			}
-		}
+		effectsLine := "   Efekty: " + formatItemEffects(item.Effects)
 
 		shopContent = append(shopContent, effectsLine)
 		shopContent = append(shopContent, "")
@@ -393,3 +346,52 @@ func (rui *RPGCharacterUI) PrintItemShop(consoleUI *ConsoleUI, shopItems []game.
 	fmt.Println(consoleUI.CenterText(shopBox))
 	fmt.Print(consoleUI.CenterText(Bold + "\nWybierz przedmiot do kupienia: " + Reset))
 }
+
+// formatItemEffects zwraca opis efektów przedmiotu
+func formatItemEffects(effects []game.RPGItemEffect) string {
+	parts := make([]string, 0, len(effects))
+	for _, effect := range effects {
+		switch effect.Type {
+		case "reveal_letter":
+			parts = append(parts, fmt.Sprintf("Odkryj %d literę", effect.Value))
+		case "reveal_hint":
+			parts = append(parts, "Odkryj podpowiedź")
+		case "suggest_letter":
+			parts = append(parts, fmt.Sprintf("Podsuń %d litery", effect.Value))
+		case "extra_life":
+			parts = append(parts, fmt.Sprintf("+%d życie", effect.Value))
+		case "intelligence_boost":
+			parts = append(parts, fmt.Sprintf("+%d inteligencji", effect.Value))
+		case "luck_boost":
+			parts = append(parts, fmt.Sprintf("+%d szczęścia", effect.Value))
+		default:
+			parts = append(parts, fmt.Sprintf("%s: %d", effect.Type, effect.Value))
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+// PrintUsableItems wyświetla przedmioty, których można użyć w trakcie gry
+func (rui *RPGCharacterUI) PrintUsableItems(consoleUI *ConsoleUI, items []game.RPGItem) {
+	itemsContent := []string{
+		Bold + Yellow + "Przedmioty do użycia:" + Reset,
+		"",
+	}
+
+	if len(items) == 0 {
+		itemsContent = append(itemsContent, "Brak przedmiotów, których można użyć")
+	} else {
+		for i, item := range items {
+			itemsContent = append(itemsContent, fmt.Sprintf("%d. %s%s%s - %s",
+				i+1, Bold, item.Name, Reset, formatItemEffects(item.Effects)))
+		}
+	}
+
+	itemsContent = append(itemsContent, "")
+	itemsContent = append(itemsContent, Bold+"0. "+Reset+"Powrót do gry")
+
+	// Wyświetl ramkę z przedmiotami
+	itemsBox := consoleUI.DrawRPGBox("Użyj Przedmiotu", itemsContent, 60)
+	fmt.Println(consoleUI.CenterText(itemsBox))
+	fmt.Print(consoleUI.CenterText(Bold + "\nWybierz przedmiot: " + Reset))
+}